@@ -0,0 +1,153 @@
+package client
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/nacl/box"
+)
+
+// Infisical encrypts secrets with AES-256-GCM using a 16 byte IV, and wraps
+// the project key for each member with NaCl box (X25519, XSalsa20-Poly1305).
+const (
+	symmetricIVSize = 16
+	nonceSize       = 24
+	keySize         = 32
+)
+
+// DecodeKey decodes a base64 encoded NaCl key.
+func DecodeKey(encoded string) (*[keySize]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return toKey(raw)
+}
+
+func toKey(raw []byte) (*[keySize]byte, error) {
+	if len(raw) != keySize {
+		return nil, fmt.Errorf("expected a %d byte key, got %d bytes", keySize, len(raw))
+	}
+
+	var key [keySize]byte
+	copy(key[:], raw)
+	return &key, nil
+}
+
+// EncryptAsymmetric seals plaintext for the owner of publicKey. The
+// ciphertext and nonce are returned base64 encoded, as the API expects them.
+func EncryptAsymmetric(plaintext []byte, publicKey string, privateKey []byte) (string, string, error) {
+	peerKey, err := DecodeKey(publicKey)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid public key: %w", err)
+	}
+	ownKey, err := toKey(privateKey)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid private key: %w", err)
+	}
+
+	var nonce [nonceSize]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return "", "", err
+	}
+
+	ciphertext := box.Seal(nil, plaintext, &nonce, peerKey, ownKey)
+
+	return base64.StdEncoding.EncodeToString(ciphertext), base64.StdEncoding.EncodeToString(nonce[:]), nil
+}
+
+// DecryptAsymmetric opens a box sealed by the owner of publicKey.
+func DecryptAsymmetric(ciphertext string, nonce string, publicKey string, privateKey []byte) ([]byte, error) {
+	peerKey, err := DecodeKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	ownKey, err := toKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	rawCiphertext, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	rawNonce, err := base64.StdEncoding.DecodeString(nonce)
+	if err != nil {
+		return nil, err
+	}
+	if len(rawNonce) != nonceSize {
+		return nil, fmt.Errorf("expected a %d byte nonce, got %d bytes", nonceSize, len(rawNonce))
+	}
+
+	var n [nonceSize]byte
+	copy(n[:], rawNonce)
+
+	plaintext, ok := box.Open(nil, rawCiphertext, &n, peerKey, ownKey)
+	if !ok {
+		return nil, errors.New("unable to decrypt, the key was not shared with this private key")
+	}
+
+	return plaintext, nil
+}
+
+// EncryptSymmetric encrypts plaintext with key and returns the base64 encoded
+// ciphertext, IV and tag.
+func EncryptSymmetric(plaintext []byte, key []byte) (string, string, string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	iv := make([]byte, symmetricIVSize)
+	if _, err := rand.Read(iv); err != nil {
+		return "", "", "", err
+	}
+
+	sealed := gcm.Seal(nil, iv, plaintext, nil)
+	ciphertext, tag := sealed[:len(sealed)-gcm.Overhead()], sealed[len(sealed)-gcm.Overhead():]
+
+	return base64.StdEncoding.EncodeToString(ciphertext),
+		base64.StdEncoding.EncodeToString(iv),
+		base64.StdEncoding.EncodeToString(tag),
+		nil
+}
+
+// DecryptSymmetric decrypts a base64 encoded ciphertext, IV and tag with key.
+func DecryptSymmetric(ciphertext string, iv string, tag string, key []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	rawCiphertext, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	rawIV, err := base64.StdEncoding.DecodeString(iv)
+	if err != nil {
+		return nil, err
+	}
+	rawTag, err := base64.StdEncoding.DecodeString(tag)
+	if err != nil {
+		return nil, err
+	}
+	if len(rawIV) != symmetricIVSize {
+		return nil, fmt.Errorf("expected a %d byte IV, got %d bytes", symmetricIVSize, len(rawIV))
+	}
+
+	return gcm.Open(nil, rawIV, append(rawCiphertext, rawTag...), nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCMWithNonceSize(block, symmetricIVSize)
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestAsymmetricRoundTrip(t *testing.T) {
+	senderPublic, senderPrivate, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receiverPublic, receiverPrivate, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ciphertext, nonce, err := EncryptAsymmetric([]byte("5d0b2f1e8c3a4b6d9e7f0a1b2c3d4e5f"), base64.StdEncoding.EncodeToString(receiverPublic[:]), senderPrivate[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plaintext, err := DecryptAsymmetric(ciphertext, nonce, base64.StdEncoding.EncodeToString(senderPublic[:]), receiverPrivate[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(plaintext) != "5d0b2f1e8c3a4b6d9e7f0a1b2c3d4e5f" {
+		t.Fatalf("unexpected plaintext %q", plaintext)
+	}
+
+	_, otherPrivate, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DecryptAsymmetric(ciphertext, nonce, base64.StdEncoding.EncodeToString(senderPublic[:]), otherPrivate[:]); err == nil {
+		t.Fatal("expected decryption with the wrong key pair to fail")
+	}
+}
+
+func TestSymmetricRoundTrip(t *testing.T) {
+	key := []byte("5d0b2f1e8c3a4b6d9e7f0a1b2c3d4e5f")
+
+	ciphertext, iv, tag, err := EncryptSymmetric([]byte("DATABASE_URL"), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plaintext, err := DecryptSymmetric(ciphertext, iv, tag, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(plaintext) != "DATABASE_URL" {
+		t.Fatalf("unexpected plaintext %q", plaintext)
+	}
+
+	if _, err := DecryptSymmetric(ciphertext, iv, tag, []byte("00000000000000000000000000000000")); err == nil {
+		t.Fatal("expected decryption with the wrong key to fail")
+	}
+}
+
+func TestDecodeKeyRejectsWrongLength(t *testing.T) {
+	if _, err := DecodeKey(base64.StdEncoding.EncodeToString([]byte("short"))); err == nil {
+		t.Fatal("expected an error for a short key")
+	}
+}
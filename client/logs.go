@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// LogsPageSize is the number of logs requested per page when the caller
+// does not ask for a specific page size.
+const LogsPageSize = 50
+
+type LogsResponse struct {
+	Logs []Log `json:"logs"`
+}
+
+// ListLogs pages through the audit logs of a project, starting at
+// params.Offset and requesting params.Limit logs per page, until the server
+// runs out of logs or max logs were collected. A max of zero or less means
+// no cap. params is not modified.
+func (c *Client) ListLogs(ctx context.Context, workspaceId string, params GetApiV1WorkspaceWorkspaceIdLogsParams, max int) ([]Log, error) {
+	var logs []Log
+	err := c.eachLogPage(ctx, workspaceId, params, func(pageSize int) int {
+		if max > 0 && max-len(logs) < pageSize {
+			return max - len(logs)
+		}
+		return pageSize
+	}, func(page []Log) bool {
+		logs = append(logs, page...)
+		return max <= 0 || len(logs) < max
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// ListLogsAfter returns the audit logs of a project that are newer than a
+// checkpoint, oldest first. Paging from the most recent log stops at the
+// log with id lastLogId. When that log no longer matches the filters, since
+// is taken to be its creation time and paging stops at the first log
+// created at or before it, so logs created at the very same time as the
+// checkpoint log are not exported. Without a lastLogId, since is the
+// inclusive start of the export. An empty lastLogId and zero since return
+// all logs. params.SortBy and params.Offset are ignored.
+func (c *Client) ListLogsAfter(ctx context.Context, workspaceId string, params GetApiV1WorkspaceWorkspaceIdLogsParams, lastLogId string, since time.Time) ([]Log, error) {
+	sortBy := Recent
+	params.SortBy = &sortBy
+	params.Offset = nil
+
+	var logs []Log
+	err := c.eachLogPage(ctx, workspaceId, params, nil, func(page []Log) bool {
+		for _, log := range page {
+			if lastLogId != "" && log.Id != nil && *log.Id == lastLogId {
+				return false
+			}
+			if !since.IsZero() && log.CreatedAt != nil {
+				createdAt, err := time.Parse(time.RFC3339, *log.CreatedAt)
+				if err == nil && (createdAt.Before(since) || lastLogId != "" && createdAt.Equal(since)) {
+					return false
+				}
+			}
+			logs = append(logs, log)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, j := 0, len(logs)-1; i < j; i, j = i+1, j-1 {
+		logs[i], logs[j] = logs[j], logs[i]
+	}
+
+	return logs, nil
+}
+
+// eachLogPage requests pages of logs starting at params.Offset until the
+// server returns a short page or visit returns false. limit, when not nil,
+// may shrink the configured page size before each request.
+func (c *Client) eachLogPage(ctx context.Context, workspaceId string, params GetApiV1WorkspaceWorkspaceIdLogsParams, limit func(pageSize int) int, visit func(page []Log) bool) error {
+	offset := 0
+	if params.Offset != nil {
+		n, err := strconv.Atoi(*params.Offset)
+		if err != nil {
+			return err
+		}
+		offset = n
+	}
+
+	pageSize := LogsPageSize
+	if params.Limit != nil {
+		n, err := strconv.Atoi(*params.Limit)
+		if err != nil {
+			return err
+		}
+		pageSize = n
+	}
+	if pageSize <= 0 {
+		pageSize = LogsPageSize
+	}
+
+	for {
+		size := pageSize
+		if limit != nil {
+			size = limit(pageSize)
+		}
+
+		page := params
+		pageOffset := strconv.Itoa(offset)
+		pageLimit := strconv.Itoa(size)
+		page.Offset = &pageOffset
+		page.Limit = &pageLimit
+
+		res, err := c.GetApiV1WorkspaceWorkspaceIdLogs(ctx, workspaceId, &page)
+		if err != nil {
+			return err
+		}
+
+		var data LogsResponse
+		if err := DecodeResponse(res, &data); err != nil {
+			return err
+		}
+
+		offset += len(data.Logs)
+
+		if !visit(data.Logs) || len(data.Logs) < size {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// newLogsServer serves total logs with ids "0".."total-1", honoring offset and limit.
+func newLogsServer(t *testing.T, total int) (*Client, *[]string) {
+	var all []Log
+	for i := 0; i < total; i++ {
+		id := strconv.Itoa(i)
+		all = append(all, Log{Id: &id})
+	}
+	return newLogsServerFor(t, all)
+}
+
+// newLogsServerFor serves the given logs in order, honoring offset and limit.
+func newLogsServerFor(t *testing.T, all []Log) (*Client, *[]string) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		logs := []Log{}
+		for i := offset; i < len(all) && i < offset+limit; i++ {
+			logs = append(logs, all[i])
+		}
+		_ = json.NewEncoder(w).Encode(LogsResponse{Logs: logs})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, &requests
+}
+
+func TestListLogsPagesUntilExhausted(t *testing.T) {
+	client, requests := newLogsServer(t, 5)
+
+	limit := "2"
+	logs, err := client.ListLogs(context.Background(), "project", GetApiV1WorkspaceWorkspaceIdLogsParams{Limit: &limit}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 5 {
+		t.Fatalf("expected 5 logs, got %d", len(logs))
+	}
+	if *logs[4].Id != "4" {
+		t.Fatalf("expected last log 4, got %s", *logs[4].Id)
+	}
+	if len(*requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(*requests))
+	}
+}
+
+func TestListLogsStopsAtMax(t *testing.T) {
+	client, requests := newLogsServer(t, 100)
+
+	offset := "10"
+	limit := "4"
+	logs, err := client.ListLogs(context.Background(), "project", GetApiV1WorkspaceWorkspaceIdLogsParams{Offset: &offset, Limit: &limit}, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 6 {
+		t.Fatalf("expected 6 logs, got %d", len(logs))
+	}
+	if *logs[0].Id != "10" || *logs[5].Id != "15" {
+		t.Fatalf("expected logs 10 to 15, got %s to %s", *logs[0].Id, *logs[5].Id)
+	}
+	if (*requests)[1] != "limit=2&offset=14" {
+		t.Fatalf("expected the last page to be trimmed, got %q", (*requests)[1])
+	}
+}
+
+func TestListLogsAfterStopsAtCheckpoint(t *testing.T) {
+	var recent []Log
+	for i := 9; i >= 0; i-- {
+		id := strconv.Itoa(i)
+		createdAt := time.Date(2023, 1, 1, 0, i, 0, 0, time.UTC).Format(time.RFC3339)
+		recent = append(recent, Log{Id: &id, CreatedAt: &createdAt})
+	}
+	client, _ := newLogsServerFor(t, recent)
+
+	limit := "3"
+	params := GetApiV1WorkspaceWorkspaceIdLogsParams{Limit: &limit}
+
+	logs, err := client.ListLogsAfter(context.Background(), "project", params, "6", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 3 || *logs[0].Id != "7" || *logs[2].Id != "9" {
+		t.Fatalf("expected logs 7 to 9 oldest first, got %v", logIds(logs))
+	}
+
+	// The checkpoint log is filtered out, so its timestamp has to stop
+	// paging without exporting it again.
+	logs, err = client.ListLogsAfter(context.Background(), "project", params, "missing", time.Date(2023, 1, 1, 0, 8, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 1 || *logs[0].Id != "9" {
+		t.Fatalf("expected log 9, got %v", logIds(logs))
+	}
+
+	// Without a checkpoint log, since is the inclusive start of the export.
+	logs, err = client.ListLogsAfter(context.Background(), "project", params, "", time.Date(2023, 1, 1, 0, 8, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 2 || *logs[0].Id != "8" {
+		t.Fatalf("expected logs 8 and 9, got %v", logIds(logs))
+	}
+
+	logs, err = client.ListLogsAfter(context.Background(), "project", params, "", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 10 || *logs[0].Id != "0" {
+		t.Fatalf("expected all logs oldest first, got %v", logIds(logs))
+	}
+}
+
+func logIds(logs []Log) []string {
+	var ids []string
+	for _, log := range logs {
+		ids = append(ids, *log.Id)
+	}
+	return ids
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// APIError is returned by DecodeResponse when the Infisical API answers with
+// a non-2xx status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected status code %d from Infisical API: %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err is an APIError for a missing object.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// DecodeResponse closes the response body, checks the status code and
+// decodes the JSON payload into out. A nil out discards the payload.
+func DecodeResponse(res *http.Response, out any) error {
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		body, _ := io.ReadAll(res.Body)
+		return &APIError{StatusCode: res.StatusCode, Body: string(body)}
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// Ptr returns a pointer to v for use in the generated request bodies, most
+// of which model their fields as *interface{}.
+func Ptr(v interface{}) *interface{} {
+	return &v
+}
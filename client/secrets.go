@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"strconv"
+)
+
+// SecretVersionsPageSize is the number of secret versions requested when the
+// caller does not ask for a specific number.
+const SecretVersionsPageSize = 20
+
+// EncryptedSecret is a secret or secret version as returned by the API,
+// with its key, value and comment encrypted with the project key.
+type EncryptedSecret struct {
+	ID          string `json:"_id"`
+	Secret      string `json:"secret"`
+	Environment string `json:"environment"`
+	Type        string `json:"type"`
+	Version     int    `json:"version"`
+	IsDeleted   bool   `json:"isDeleted"`
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+
+	SecretKeyCiphertext     string `json:"secretKeyCiphertext"`
+	SecretKeyIV             string `json:"secretKeyIV"`
+	SecretKeyTag            string `json:"secretKeyTag"`
+	SecretValueCiphertext   string `json:"secretValueCiphertext"`
+	SecretValueIV           string `json:"secretValueIV"`
+	SecretValueTag          string `json:"secretValueTag"`
+	SecretCommentCiphertext string `json:"secretCommentCiphertext"`
+	SecretCommentIV         string `json:"secretCommentIV"`
+	SecretCommentTag        string `json:"secretCommentTag"`
+}
+
+// PlainSecret is a decrypted secret or secret version.
+type PlainSecret struct {
+	// ID is the identifier of the secret, or of the version for secret versions.
+	ID string
+	// SecretId is the identifier of the secret the version belongs to, or ID for secrets.
+	SecretId    string
+	Environment string
+	Type        string
+	Version     int
+	IsDeleted   bool
+	CreatedAt   string
+	Key         string
+	Value       string
+	Comment     string
+}
+
+// Decrypt decrypts the key, value and comment with the project key.
+func (e *EncryptedSecret) Decrypt(projectKey []byte) (PlainSecret, error) {
+	secret := PlainSecret{
+		ID:          e.ID,
+		SecretId:    e.Secret,
+		Environment: e.Environment,
+		Type:        e.Type,
+		Version:     e.Version,
+		IsDeleted:   e.IsDeleted,
+		CreatedAt:   e.CreatedAt,
+	}
+	if secret.SecretId == "" {
+		secret.SecretId = e.ID
+	}
+
+	key, err := DecryptSymmetric(e.SecretKeyCiphertext, e.SecretKeyIV, e.SecretKeyTag, projectKey)
+	if err != nil {
+		return PlainSecret{}, err
+	}
+	secret.Key = string(key)
+
+	value, err := DecryptSymmetric(e.SecretValueCiphertext, e.SecretValueIV, e.SecretValueTag, projectKey)
+	if err != nil {
+		return PlainSecret{}, err
+	}
+	secret.Value = string(value)
+
+	// Comments are optional and missing from secret versions.
+	if e.SecretCommentCiphertext != "" {
+		comment, err := DecryptSymmetric(e.SecretCommentCiphertext, e.SecretCommentIV, e.SecretCommentTag, projectKey)
+		if err != nil {
+			return PlainSecret{}, err
+		}
+		secret.Comment = string(comment)
+	}
+
+	return secret, nil
+}
+
+// DecryptSecrets decrypts secrets with the project key of workspaceId,
+// skipping deleted secret versions.
+func (s *Session) DecryptSecrets(ctx context.Context, workspaceId string, secrets []EncryptedSecret) ([]PlainSecret, error) {
+	projectKey, err := s.ProjectKey(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var plain []PlainSecret
+	for i := range secrets {
+		if secrets[i].IsDeleted {
+			continue
+		}
+		secret, err := secrets[i].Decrypt(projectKey)
+		if err != nil {
+			return nil, err
+		}
+		plain = append(plain, secret)
+	}
+
+	return plain, nil
+}
+
+type SecretsResponse struct {
+	Secrets []EncryptedSecret `json:"secrets"`
+}
+
+// ListSecrets fetches and decrypts the secrets of an environment.
+func (s *Session) ListSecrets(ctx context.Context, workspaceId string, environment string) ([]PlainSecret, error) {
+	secrets, err := s.ListEncryptedSecrets(ctx, workspaceId, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DecryptSecrets(ctx, workspaceId, secrets)
+}
+
+// ListEncryptedSecrets fetches the secrets of an environment without
+// decrypting them, falling back to the v1 endpoint on servers without the
+// v2 one.
+func (s *Session) ListEncryptedSecrets(ctx context.Context, workspaceId string, environment string) ([]EncryptedSecret, error) {
+	if s.Supports(APIv2) {
+		res, err := s.GetApiV2Secrets(ctx, &GetApiV2SecretsParams{
+			WorkspaceId: workspaceId,
+			Environment: environment,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var data SecretsResponse
+		err = DecodeResponse(res, &data)
+		if !IsNotFound(err) {
+			return data.Secrets, err
+		}
+	}
+
+	res, err := s.GetApiV1SecretWorkspaceId(ctx, workspaceId, &GetApiV1SecretWorkspaceIdParams{
+		Environment: &environment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var data SecretsResponse
+	if err := DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	return data.Secrets, nil
+}
+
+type SecretVersionsResponse struct {
+	SecretVersions []EncryptedSecret `json:"secretVersions"`
+}
+
+// ListSecretVersions fetches and decrypts a page of the versions of a
+// secret, including the versions that deleted it.
+func (s *Session) ListSecretVersions(ctx context.Context, workspaceId string, secretId string, offset int, limit int) ([]PlainSecret, error) {
+	pageOffset := strconv.Itoa(offset)
+	pageLimit := strconv.Itoa(limit)
+	res, err := s.GetApiV1SecretSecretIdSecretVersions(ctx, secretId, &GetApiV1SecretSecretIdSecretVersionsParams{
+		Offset: &pageOffset,
+		Limit:  &pageLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var data SecretVersionsResponse
+	if err := DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	projectKey, err := s.ProjectKey(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []PlainSecret
+	for i := range data.SecretVersions {
+		version, err := data.SecretVersions[i].Decrypt(projectKey)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestEncryptedSecretDecrypt(t *testing.T) {
+	projectKey := []byte("5d0b2f1e8c3a4b6d9e7f0a1b2c3d4e5f")
+
+	encrypted := EncryptedSecret{ID: "version", Secret: "secret", Environment: "dev", Type: "shared", Version: 3}
+	var err error
+	encrypted.SecretKeyCiphertext, encrypted.SecretKeyIV, encrypted.SecretKeyTag, err = EncryptSymmetric([]byte("DATABASE_URL"), projectKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted.SecretValueCiphertext, encrypted.SecretValueIV, encrypted.SecretValueTag, err = EncryptSymmetric([]byte("postgres://localhost"), projectKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret, err := encrypted.Decrypt(projectKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret.Key != "DATABASE_URL" || secret.Value != "postgres://localhost" || secret.Comment != "" {
+		t.Fatalf("unexpected secret %+v", secret)
+	}
+	if secret.SecretId != "secret" || secret.Environment != "dev" || secret.Version != 3 {
+		t.Fatalf("unexpected metadata %+v", secret)
+	}
+
+	if _, err := encrypted.Decrypt([]byte("00000000000000000000000000000000")); err == nil {
+		t.Fatal("expected decryption with the wrong project key to fail")
+	}
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"errors"
+)
+
+// Session is handed to data sources and resources by the provider. It couples
+// the API client with the caller's key material, which is required for the
+// end-to-end encrypted parts of the API.
+type Session struct {
+	*Client
+
+	// PrivateKey is the caller's NaCl private key, nil when none was configured.
+	PrivateKey []byte
+
+	// ServiceToken is set when the caller authenticates with a service token
+	// rather than an API key.
+	ServiceToken bool
+
+	// Capabilities of the server, nil when they could not be detected.
+	Capabilities *Capabilities
+}
+
+// Supports reports whether the server serves generation, assuming it does
+// when the capabilities are unknown.
+func (s *Session) Supports(generation APIGeneration) bool {
+	return s.Capabilities == nil || s.Capabilities.Supports(generation)
+}
+
+// ErrMissingPrivateKey is returned by operations that need to decrypt or
+// re-encrypt the project key when the provider has no private key.
+var ErrMissingPrivateKey = errors.New("this operation requires the private_key provider attribute or the INFISICAL_PRIVATE_KEY environment variable")
+
+type LatestKeyResponse struct {
+	LatestKey EncryptedKeyResponse `json:"latestKey"`
+}
+
+type EncryptedKeyResponse struct {
+	EncryptedKey string `json:"encryptedKey"`
+	Nonce        string `json:"nonce"`
+	Sender       struct {
+		PublicKey string `json:"publicKey"`
+	} `json:"sender"`
+}
+
+// ProjectKey fetches the project key shared with the caller and decrypts it.
+func (s *Session) ProjectKey(ctx context.Context, workspaceId string) ([]byte, error) {
+	if s.PrivateKey == nil {
+		return nil, ErrMissingPrivateKey
+	}
+
+	data, err := s.encryptedKey(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecryptAsymmetric(data.EncryptedKey, data.Nonce, data.Sender.PublicKey, s.PrivateKey)
+}
+
+// encryptedKey fetches the project key shared with the caller, falling back
+// to the v1 endpoint on servers without the v2 one.
+func (s *Session) encryptedKey(ctx context.Context, workspaceId string) (*EncryptedKeyResponse, error) {
+	if s.Supports(APIv2) {
+		res, err := s.GetApiV2WorkspaceWorkspaceIdEncryptedKey(ctx, workspaceId)
+		if err != nil {
+			return nil, err
+		}
+
+		var data EncryptedKeyResponse
+		err = DecodeResponse(res, &data)
+		if !IsNotFound(err) {
+			return &data, err
+		}
+	}
+
+	res, err := s.GetApiV1KeyWorkspaceIdLatest(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var data LatestKeyResponse
+	if err := DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	return &data.LatestKey, nil
+}
+
+// WrapProjectKey decrypts the project key and encrypts it to publicKey, so
+// that only the holder of the matching private key can read it.
+func (s *Session) WrapProjectKey(ctx context.Context, workspaceId string, publicKey string) (encryptedKey string, nonce string, err error) {
+	projectKey, err := s.ProjectKey(ctx, workspaceId)
+	if err != nil {
+		return "", "", err
+	}
+
+	return EncryptAsymmetric(projectKey, publicKey, s.PrivateKey)
+}
+
+// ShareProjectKey encrypts the project key to publicKey and uploads it for
+// userId, which allows that user to decrypt the project's secrets.
+func (s *Session) ShareProjectKey(ctx context.Context, workspaceId string, userId string, publicKey string) error {
+	encryptedKey, nonce, err := s.WrapProjectKey(ctx, workspaceId, publicKey)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.PostApiV1KeyWorkspaceId(ctx, workspaceId, PostApiV1KeyWorkspaceIdJSONRequestBody{
+		Key: Ptr(map[string]string{
+			"userId":       userId,
+			"encryptedKey": encryptedKey,
+			"nonce":        nonce,
+		}),
+	})
+	if err != nil {
+		return err
+	}
+
+	return DecodeResponse(res, nil)
+}
+
+type UserResponse struct {
+	User struct {
+		ID    string `json:"_id"`
+		Email string `json:"email"`
+	} `json:"user"`
+}
+
+// CurrentUserEmail fetches the email address of the user the session
+// authenticates as, falling back to the v1 endpoint on servers without the
+// v2 one.
+func (s *Session) CurrentUserEmail(ctx context.Context) (string, error) {
+	var data UserResponse
+	if s.Supports(APIv2) {
+		res, err := s.GetApiV2UsersMe(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		err = DecodeResponse(res, &data)
+		if !IsNotFound(err) {
+			return data.User.Email, err
+		}
+	}
+
+	res, err := s.GetApiV1User(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if err := DecodeResponse(res, &data); err != nil {
+		return "", err
+	}
+
+	return data.User.Email, nil
+}
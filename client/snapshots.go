@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"strconv"
+)
+
+// SnapshotsPageSize is the number of snapshots requested per page when
+// searching for a snapshot version.
+const SnapshotsPageSize = 50
+
+// Snapshot is a point-in-time copy of the secrets of a project. Listing
+// snapshots only returns the identifiers of their secret versions.
+type Snapshot struct {
+	ID             string   `json:"_id"`
+	Workspace      string   `json:"workspace"`
+	Version        int      `json:"version"`
+	CreatedAt      string   `json:"createdAt"`
+	SecretVersions []string `json:"secretVersions"`
+}
+
+type SnapshotsResponse struct {
+	SecretSnapshots []Snapshot `json:"secretSnapshots"`
+}
+
+type SnapshotsCountResponse struct {
+	Count int `json:"count"`
+}
+
+type SnapshotResponse struct {
+	SecretSnapshot struct {
+		ID             string            `json:"_id"`
+		Workspace      string            `json:"workspace"`
+		Version        int               `json:"version"`
+		CreatedAt      string            `json:"createdAt"`
+		SecretVersions []EncryptedSecret `json:"secretVersions"`
+	} `json:"secretSnapshot"`
+}
+
+// ListSnapshots fetches a page of the snapshots of a project, most recent first.
+func (c *Client) ListSnapshots(ctx context.Context, workspaceId string, offset int, limit int) ([]Snapshot, error) {
+	pageOffset := strconv.Itoa(offset)
+	pageLimit := strconv.Itoa(limit)
+	res, err := c.GetApiV1WorkspaceWorkspaceIdSecretSnapshots(ctx, workspaceId, &GetApiV1WorkspaceWorkspaceIdSecretSnapshotsParams{
+		Offset: &pageOffset,
+		Limit:  &pageLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var data SnapshotsResponse
+	if err := DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	return data.SecretSnapshots, nil
+}
+
+// CountSnapshots returns the number of snapshots of a project.
+func (c *Client) CountSnapshots(ctx context.Context, workspaceId string) (int, error) {
+	res, err := c.GetApiV1WorkspaceWorkspaceIdSecretSnapshotsCount(ctx, workspaceId)
+	if err != nil {
+		return 0, err
+	}
+
+	var data SnapshotsCountResponse
+	if err := DecodeResponse(res, &data); err != nil {
+		return 0, err
+	}
+
+	return data.Count, nil
+}
+
+// FindSnapshot pages through the snapshots of a project until it finds the
+// given version. It returns nil when the project has no such snapshot.
+func (c *Client) FindSnapshot(ctx context.Context, workspaceId string, version int) (*Snapshot, error) {
+	for offset := 0; ; offset += SnapshotsPageSize {
+		snapshots, err := c.ListSnapshots(ctx, workspaceId, offset, SnapshotsPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range snapshots {
+			if snapshots[i].Version == version {
+				return &snapshots[i], nil
+			}
+		}
+
+		// Snapshots are listed most recent first, so older pages cannot
+		// contain a newer version.
+		if len(snapshots) < SnapshotsPageSize || snapshots[len(snapshots)-1].Version < version {
+			return nil, nil
+		}
+	}
+}
+
+// SnapshotSecrets fetches a snapshot and decrypts the secrets it contains.
+func (s *Session) SnapshotSecrets(ctx context.Context, workspaceId string, snapshotId string) ([]PlainSecret, error) {
+	res, err := s.GetApiV1SecretSnapshotSecretSnapshotId(ctx, snapshotId)
+	if err != nil {
+		return nil, err
+	}
+
+	var data SnapshotResponse
+	if err := DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	return s.DecryptSecrets(ctx, workspaceId, data.SecretSnapshot.SecretVersions)
+}
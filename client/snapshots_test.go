@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// newSnapshotsServer serves snapshots with versions total..1, most recent first.
+func newSnapshotsServer(t *testing.T, total int) (*Client, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		snapshots := []Snapshot{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			snapshots = append(snapshots, Snapshot{ID: "snapshot-" + strconv.Itoa(total-i), Version: total - i})
+		}
+		_ = json.NewEncoder(w).Encode(SnapshotsResponse{SecretSnapshots: snapshots})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, &requests
+}
+
+func TestFindSnapshot(t *testing.T) {
+	client, requests := newSnapshotsServer(t, 120)
+
+	snapshot, err := client.FindSnapshot(context.Background(), "project", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot == nil || snapshot.ID != "snapshot-3" {
+		t.Fatalf("expected snapshot-3, got %+v", snapshot)
+	}
+	if *requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", *requests)
+	}
+
+	snapshot, err = client.FindSnapshot(context.Background(), "project", 121)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot != nil {
+		t.Fatalf("expected no snapshot, got %+v", snapshot)
+	}
+	if *requests != 4 {
+		t.Fatalf("expected paging to stop after the first page, got %d requests", *requests)
+	}
+}
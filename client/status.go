@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIGeneration is a major version of the Infisical API, served under
+// /api/v1 and /api/v2 respectively.
+type APIGeneration string
+
+const (
+	APIv1 APIGeneration = "v1"
+	APIv2 APIGeneration = "v2"
+)
+
+// StatusResponse is returned by the status endpoint. Only newer servers
+// report their version.
+type StatusResponse struct {
+	Date    string `json:"date"`
+	Message string `json:"message"`
+	Version string `json:"version"`
+}
+
+// Capabilities describes what the server supports.
+type Capabilities struct {
+	// Version of the server, empty when it does not report one.
+	Version string
+
+	// Generations lists the API generations the server serves.
+	Generations []APIGeneration
+}
+
+// UnsupportedError is returned when a request targets an API generation the
+// server does not serve.
+type UnsupportedError struct {
+	Generation APIGeneration
+	Version    string
+	Request    string
+}
+
+func (e *UnsupportedError) Error() string {
+	server := "the Infisical server"
+	if e.Version != "" {
+		server = fmt.Sprintf("Infisical server version %s", e.Version)
+	}
+	return fmt.Sprintf("%s uses the %s API, which %s does not support", e.Request, e.Generation, server)
+}
+
+// Supports reports whether the server serves generation.
+func (c *Capabilities) Supports(generation APIGeneration) bool {
+	for _, g := range c.Generations {
+		if g == generation {
+			return true
+		}
+	}
+	return false
+}
+
+// Intercept is a RequestEditorFn that fails requests to API generations the
+// server does not serve, before they are sent.
+func (c *Capabilities) Intercept(_ context.Context, req *http.Request) error {
+	for _, generation := range []APIGeneration{APIv1, APIv2} {
+		if strings.Contains(req.URL.Path, "/api/"+string(generation)+"/") && !c.Supports(generation) {
+			return &UnsupportedError{
+				Generation: generation,
+				Version:    c.Version,
+				Request:    req.Method + " " + req.URL.Path,
+			}
+		}
+	}
+	return nil
+}
+
+// DetectCapabilities checks the server status and probes which API
+// generations it serves. Each generation is probed with an endpoint that
+// the caller's credentials can access; only a 404 counts as missing.
+func (c *Client) DetectCapabilities(ctx context.Context, serviceToken bool) (*Capabilities, error) {
+	res, err := c.GetApiStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var status StatusResponse
+	if err := DecodeResponse(res, &status); err != nil {
+		return nil, err
+	}
+
+	probes := map[APIGeneration]func(context.Context, ...RequestEditorFn) (*http.Response, error){
+		APIv1: c.GetApiV1User,
+		APIv2: c.GetApiV2UsersMe,
+	}
+	if serviceToken {
+		probes[APIv1] = c.GetApiV1ServiceToken
+		probes[APIv2] = c.GetApiV2ServiceToken
+	}
+
+	capabilities := &Capabilities{Version: status.Version}
+	for _, generation := range []APIGeneration{APIv1, APIv2} {
+		res, err := probes[generation](ctx)
+		if err != nil {
+			return nil, err
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusNotFound {
+			capabilities.Generations = append(capabilities.Generations, generation)
+		}
+	}
+
+	return capabilities, nil
+}
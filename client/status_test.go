@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newStatusServer serves the status endpoint and answers 404 for every
+// route under the missing generations.
+func newStatusServer(t *testing.T, version string, missing ...APIGeneration) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, generation := range missing {
+			if strings.HasPrefix(r.URL.Path, "/api/"+string(generation)+"/") {
+				http.NotFound(w, r)
+				return
+			}
+		}
+		if r.URL.Path == "/api/status" {
+			_, _ = w.Write([]byte(`{"date":"2023-03-01T00:00:00.000Z","message":"Ok","version":"` + version + `"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestDetectCapabilities(t *testing.T) {
+	client := newStatusServer(t, "0.3.0", APIv1)
+
+	capabilities, err := client.DetectCapabilities(context.Background(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if capabilities.Version != "0.3.0" {
+		t.Fatalf("expected version 0.3.0, got %q", capabilities.Version)
+	}
+	if capabilities.Supports(APIv1) || !capabilities.Supports(APIv2) {
+		t.Fatalf("expected only v2, got %v", capabilities.Generations)
+	}
+}
+
+func TestCapabilitiesInterceptRejectsUnsupportedGeneration(t *testing.T) {
+	client := newStatusServer(t, "", APIv2)
+
+	capabilities, err := client.DetectCapabilities(context.Background(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.RequestEditors = append(client.RequestEditors, capabilities.Intercept)
+
+	if _, err := client.GetApiV1ServiceToken(context.Background()); err != nil {
+		t.Fatalf("expected v1 request to be sent, got %v", err)
+	}
+
+	_, err = client.GetApiV2ServiceToken(context.Background())
+	var unsupported *UnsupportedError
+	if !errors.As(err, &unsupported) || unsupported.Generation != APIv2 {
+		t.Fatalf("expected unsupported v2 error, got %v", err)
+	}
+}
+
+func TestSessionSupportsUnknownCapabilities(t *testing.T) {
+	session := &Session{}
+	if !session.Supports(APIv1) || !session.Supports(APIv2) {
+		t.Fatal("expected every generation to be assumed without capabilities")
+	}
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+)
+
+// Workspace is a project as returned by the API, which still calls projects workspaces.
+type Workspace struct {
+	ID           string                 `json:"_id"`
+	Name         string                 `json:"name"`
+	Organization string                 `json:"organization"`
+	Environments []WorkspaceEnvironment `json:"environments"`
+}
+
+// WorkspaceEnvironment is an environment of a project.
+type WorkspaceEnvironment struct {
+	ID   string `json:"_id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type WorkspaceResponse struct {
+	Workspace Workspace `json:"workspace"`
+}
+
+// GetWorkspace fetches a project and its environments.
+func (c *Client) GetWorkspace(ctx context.Context, workspaceId string) (*Workspace, error) {
+	res, err := c.GetApiV1WorkspaceWorkspaceId(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var data WorkspaceResponse
+	if err := DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	return &data.Workspace, nil
+}
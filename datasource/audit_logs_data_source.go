@@ -0,0 +1,399 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &AuditLogsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &AuditLogsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &AuditLogsDataSource{}
+)
+
+// defaultMaxAuditLogs caps the number of logs fetched when max_logs is not set.
+const defaultMaxAuditLogs = 500
+
+// NewAuditLogsDataSource is a helper function to simplify the provider implementation.
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &AuditLogsDataSource{}
+}
+
+// AuditLogsDataSource is the data source implementation.
+type AuditLogsDataSource struct {
+	client *ic.Session
+}
+
+// AuditLogsDataSourceModel maps the data source schema data.
+type AuditLogsDataSourceModel struct {
+	ID          types.String     `tfsdk:"id"`
+	ProjectId   types.String     `tfsdk:"project_id"`
+	UserId      types.String     `tfsdk:"user_id"`
+	ActionNames []types.String   `tfsdk:"action_names"`
+	SortBy      types.String     `tfsdk:"sort_by"`
+	Offset      types.Int64      `tfsdk:"offset"`
+	Limit       types.Int64      `tfsdk:"limit"`
+	MaxLogs     types.Int64      `tfsdk:"max_logs"`
+	Logs        []AuditLogsModel `tfsdk:"logs"`
+}
+
+// AuditLogsModel maps logs schema data.
+type AuditLogsModel struct {
+	ID          types.String          `tfsdk:"id"`
+	ActionNames []types.String        `tfsdk:"action_names"`
+	Actions     []AuditLogActionModel `tfsdk:"actions"`
+	User        *AuditLogUserModel    `tfsdk:"user"`
+	Channel     types.String          `tfsdk:"channel"`
+	IpAddress   types.String          `tfsdk:"ip_address"`
+	CreatedAt   types.String          `tfsdk:"created_at"`
+}
+
+// AuditLogActionModel maps actions schema data.
+type AuditLogActionModel struct {
+	Name           types.String                 `tfsdk:"name"`
+	UserId         types.String                 `tfsdk:"user_id"`
+	SecretVersions []AuditLogSecretVersionModel `tfsdk:"secret_versions"`
+}
+
+// AuditLogSecretVersionModel maps secret versions schema data.
+type AuditLogSecretVersionModel struct {
+	OldSecretVersion types.String `tfsdk:"old_secret_version"`
+	NewSecretVersion types.String `tfsdk:"new_secret_version"`
+}
+
+// AuditLogUserModel maps user schema data.
+type AuditLogUserModel struct {
+	ID        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *AuditLogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+// Schema defines the schema for the data source.
+func (d *AuditLogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the audit logs of a project, paging through the results automatically.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Hash of the arguments and the returned log identifiers.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Only return logs of this project member.",
+				Optional:    true,
+			},
+			"action_names": schema.ListAttribute{
+				Description: "Only return logs containing one of these actions, e.g. readSecrets or updateSecrets.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"sort_by": schema.StringAttribute{
+				Description: "Order of the logs, either recent or oldest. Defaults to recent.",
+				Optional:    true,
+			},
+			"offset": schema.Int64Attribute{
+				Description: "Number of logs to skip. Defaults to 0.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("Number of logs to request per page. Defaults to %d.", ic.LogsPageSize),
+				Optional:    true,
+			},
+			"max_logs": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of logs to fetch across all pages. Defaults to %d.", defaultMaxAuditLogs),
+				Optional:    true,
+			},
+			"logs": schema.ListNestedAttribute{
+				Description: "List of logs.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the log.",
+							Computed:    true,
+						},
+						"action_names": schema.ListAttribute{
+							Description: "Names of the actions in the log.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"actions": schema.ListNestedAttribute{
+							Description: "Actions in the log.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "Name of the action.",
+										Computed:    true,
+									},
+									"user_id": schema.StringAttribute{
+										Description: "Identifier of the user who performed the action.",
+										Computed:    true,
+									},
+									"secret_versions": schema.ListNestedAttribute{
+										Description: "Secret versions the action changed.",
+										Computed:    true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"old_secret_version": schema.StringAttribute{
+													Description: "Identifier of the secret version before the action.",
+													Computed:    true,
+												},
+												"new_secret_version": schema.StringAttribute{
+													Description: "Identifier of the secret version after the action.",
+													Computed:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"user": schema.SingleNestedAttribute{
+							Description: "User who created the log.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Description: "Identifier of the user.",
+									Computed:    true,
+								},
+								"email": schema.StringAttribute{
+									Description: "Email address of the user.",
+									Computed:    true,
+								},
+								"first_name": schema.StringAttribute{
+									Description: "First name of the user.",
+									Computed:    true,
+								},
+								"last_name": schema.StringAttribute{
+									Description: "Last name of the user.",
+									Computed:    true,
+								},
+							},
+						},
+						"channel": schema.StringAttribute{
+							Description: "Channel the actions were performed through, e.g. web or cli.",
+							Computed:    true,
+						},
+						"ip_address": schema.StringAttribute{
+							Description: "IP address the actions were performed from.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Time the log was created.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *AuditLogsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks the sort order and the paging attributes.
+func (d *AuditLogsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var sortBy types.String
+	var offset, limit, maxLogs types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sort_by"), &sortBy)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("offset"), &offset)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("limit"), &limit)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_logs"), &maxLogs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !sortBy.IsNull() && !sortBy.IsUnknown() {
+		value := ic.GetApiV1WorkspaceWorkspaceIdLogsParamsSortBy(sortBy.ValueString())
+		if value != ic.Recent && value != ic.Oldest {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sort_by"),
+				"Invalid Sort Order",
+				fmt.Sprintf("Expected one of %s, %s, got: %q.", ic.Recent, ic.Oldest, sortBy.ValueString()),
+			)
+		}
+	}
+
+	if !offset.IsNull() && !offset.IsUnknown() && offset.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("offset"),
+			"Invalid Offset",
+			"Expected offset to be at least 0.",
+		)
+	}
+
+	for name, value := range map[string]types.Int64{"limit": limit, "max_logs": maxLogs} {
+		if !value.IsNull() && !value.IsUnknown() && value.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Page Size",
+				fmt.Sprintf("Expected %s to be at least 1.", name),
+			)
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *AuditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state AuditLogsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var params ic.GetApiV1WorkspaceWorkspaceIdLogsParams
+	if !state.UserId.IsNull() {
+		userId := state.UserId.ValueString()
+		params.UserId = &userId
+	}
+	if len(state.ActionNames) > 0 {
+		var names []string
+		for _, name := range state.ActionNames {
+			names = append(names, name.ValueString())
+		}
+		actionNames := strings.Join(names, ",")
+		params.ActionNames = &actionNames
+	}
+	if !state.SortBy.IsNull() {
+		sortBy := ic.GetApiV1WorkspaceWorkspaceIdLogsParamsSortBy(state.SortBy.ValueString())
+		params.SortBy = &sortBy
+	}
+	if !state.Offset.IsNull() {
+		offset := strconv.FormatInt(state.Offset.ValueInt64(), 10)
+		params.Offset = &offset
+	}
+	if !state.Limit.IsNull() {
+		limit := strconv.FormatInt(state.Limit.ValueInt64(), 10)
+		params.Limit = &limit
+	}
+
+	maxLogs := defaultMaxAuditLogs
+	if !state.MaxLogs.IsNull() {
+		maxLogs = int(state.MaxLogs.ValueInt64())
+	}
+
+	logs, err := d.client.ListLogs(ctx, state.ProjectId.ValueString(), params, maxLogs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Audit Logs",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Logs = []AuditLogsModel{}
+	var ids []string
+	for _, log := range logs {
+		model := newAuditLogsModel(log)
+		state.Logs = append(state.Logs, model)
+		ids = append(ids, model.ID.ValueString())
+	}
+
+	scope := []string{
+		state.ProjectId.ValueString(),
+		state.UserId.ValueString(),
+		state.SortBy.ValueString(),
+		state.Offset.String(),
+		state.Limit.String(),
+		state.MaxLogs.String(),
+	}
+	for _, actionName := range state.ActionNames {
+		scope = append(scope, actionName.ValueString())
+	}
+
+	state.ID = types.StringValue(contentID(scope, ids))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// newAuditLogsModel flattens a log of the generated client into the schema model.
+func newAuditLogsModel(log ic.Log) AuditLogsModel {
+	model := AuditLogsModel{
+		ID:          stringOrNull(log.Id),
+		ActionNames: []types.String{},
+		Actions:     []AuditLogActionModel{},
+		Channel:     stringOrNull(log.Channel),
+		IpAddress:   stringOrNull(log.IpAddress),
+		CreatedAt:   stringOrNull(log.CreatedAt),
+	}
+
+	if log.ActionNames != nil {
+		for _, name := range *log.ActionNames {
+			model.ActionNames = append(model.ActionNames, types.StringValue(name))
+		}
+	}
+
+	if log.Actions != nil {
+		for _, action := range *log.Actions {
+			actionModel := AuditLogActionModel{
+				Name:           stringOrNull(action.Name),
+				UserId:         stringOrNull(action.User),
+				SecretVersions: []AuditLogSecretVersionModel{},
+			}
+			if action.Payload != nil {
+				for _, payload := range *action.Payload {
+					actionModel.SecretVersions = append(actionModel.SecretVersions, AuditLogSecretVersionModel{
+						OldSecretVersion: stringOrNull(payload.OldSecretVersion),
+						NewSecretVersion: stringOrNull(payload.NewSecretVersion),
+					})
+				}
+			}
+			model.Actions = append(model.Actions, actionModel)
+		}
+	}
+
+	if log.User != nil {
+		model.User = &AuditLogUserModel{
+			ID:        stringOrNull(log.User.Id),
+			Email:     stringOrNull(log.User.Email),
+			FirstName: stringOrNull(log.User.FirstName),
+			LastName:  stringOrNull(log.User.LastName),
+		}
+	}
+
+	return model
+}
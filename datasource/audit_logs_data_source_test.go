@@ -0,0 +1,41 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var auditLogsConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+data "infisical_audit_logs" "test" {
+    project_id   = data.infisical_projects.test.projects.0.id
+    action_names = ["readSecrets"]
+    sort_by      = "oldest"
+    limit        = 2
+    max_logs     = 3
+}
+`
+
+func TestAccAuditLogsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + auditLogsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.infisical_audit_logs.test", "logs.#"),
+					resource.TestCheckResourceAttr("data.infisical_audit_logs.test", "logs.0.action_names.0", "readSecrets"),
+				),
+			},
+		},
+	})
+}
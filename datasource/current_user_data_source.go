@@ -0,0 +1,291 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &CurrentUserDataSource{}
+	_ datasource.DataSourceWithConfigure = &CurrentUserDataSource{}
+)
+
+// NewCurrentUserDataSource is a helper function to simplify the provider implementation.
+func NewCurrentUserDataSource() datasource.DataSource {
+	return &CurrentUserDataSource{}
+}
+
+// CurrentUserDataSource is the data source implementation.
+type CurrentUserDataSource struct {
+	client *ic.Session
+}
+
+// CurrentUserDataSourceModel maps the data source schema data.
+type CurrentUserDataSourceModel struct {
+	ID           types.String       `tfsdk:"id"`
+	Email        types.String       `tfsdk:"email"`
+	FirstName    types.String       `tfsdk:"first_name"`
+	LastName     types.String       `tfsdk:"last_name"`
+	PublicKey    types.String       `tfsdk:"public_key"`
+	CreatedAt    types.String       `tfsdk:"created_at"`
+	UpdatedAt    types.String       `tfsdk:"updated_at"`
+	AuthMethod   types.String       `tfsdk:"auth_method"`
+	ServiceToken *ServiceTokenModel `tfsdk:"service_token"`
+}
+
+// ServiceTokenModel maps service token schema data.
+type ServiceTokenModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	ProjectId   types.String `tfsdk:"project_id"`
+	Environment types.String `tfsdk:"environment"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *CurrentUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+// Schema defines the schema for the data source.
+func (d *CurrentUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the user the provider is authenticated as. With a service token, " +
+			"this is the user who created the token, and service_token describes the token itself.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the user.",
+				Computed:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address of the user.",
+				Computed:    true,
+			},
+			"first_name": schema.StringAttribute{
+				Description: "First name of the user.",
+				Computed:    true,
+			},
+			"last_name": schema.StringAttribute{
+				Description: "Last name of the user.",
+				Computed:    true,
+			},
+			"public_key": schema.StringAttribute{
+				Description: "Public key of the user, which project keys are encrypted to.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Time the user was created.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Time the user was last updated.",
+				Computed:    true,
+			},
+			"auth_method": schema.StringAttribute{
+				Description: "How the provider is authenticated, either api_key or service_token.",
+				Computed:    true,
+			},
+			"service_token": schema.SingleNestedAttribute{
+				Description: "Service token the provider is authenticated with, null for API keys.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "Identifier of the service token.",
+						Computed:    true,
+					},
+					"name": schema.StringAttribute{
+						Description: "Name of the service token.",
+						Computed:    true,
+					},
+					"project_id": schema.StringAttribute{
+						Description: "Identifier of the project the token grants access to.",
+						Computed:    true,
+					},
+					"environment": schema.StringAttribute{
+						Description: "Slug of the environment the token grants access to.",
+						Computed:    true,
+					},
+					"expires_at": schema.StringAttribute{
+						Description: "Time the token expires.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *CurrentUserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// CurrentUser omits the encrypted private key the API returns with the user.
+type CurrentUser struct {
+	ID        string `json:"_id"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	PublicKey string `json:"publicKey"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type CurrentUserResponse struct {
+	User CurrentUser `json:"user"`
+}
+
+type ServiceTokenDataResponse struct {
+	ID          string `json:"_id"`
+	Name        string `json:"name"`
+	Workspace   string `json:"workspace"`
+	Environment string `json:"environment"`
+	ExpiresAt   string `json:"expiresAt"`
+	// User is either the identifier of the creator or the populated user.
+	User json.RawMessage `json:"user"`
+}
+
+// readUser fetches the user from the v2 endpoint, falling back to v1 on
+// servers without it.
+func (d *CurrentUserDataSource) readUser(ctx context.Context) (*CurrentUser, error) {
+	var data CurrentUserResponse
+	if d.client.Supports(ic.APIv2) {
+		res, err := d.client.GetApiV2UsersMe(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ic.DecodeResponse(res, &data)
+		if !ic.IsNotFound(err) {
+			return &data.User, err
+		}
+	}
+
+	res, err := d.client.GetApiV1User(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	return &data.User, nil
+}
+
+// readServiceToken fetches the service token and the identity of its creator.
+func (d *CurrentUserDataSource) readServiceToken(ctx context.Context) (*ServiceTokenDataResponse, *CurrentUser, error) {
+	getServiceToken := d.client.GetApiV2ServiceToken
+	if !d.client.Supports(ic.APIv2) {
+		getServiceToken = d.client.GetApiV1ServiceToken
+	}
+
+	res, err := getServiceToken(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data ServiceTokenDataResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		return nil, nil, err
+	}
+
+	user, err := decodeUserRef(data.User)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &data, user, nil
+}
+
+// decodeUserRef decodes a user reference, which the API returns either as
+// the identifier of the user or as the populated user.
+func decodeUserRef(raw json.RawMessage) (*CurrentUser, error) {
+	user := &CurrentUser{}
+	if len(raw) > 0 && raw[0] == '"' {
+		if err := json.Unmarshal(raw, &user.ID); err != nil {
+			return nil, err
+		}
+	} else if len(raw) > 0 && string(raw) != "null" {
+		if err := json.Unmarshal(raw, user); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *CurrentUserDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state CurrentUserDataSourceModel
+
+	var user *CurrentUser
+	if d.client.ServiceToken {
+		token, tokenUser, err := d.readServiceToken(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Infisical Service Token",
+				err.Error(),
+			)
+			return
+		}
+		user = tokenUser
+		state.AuthMethod = types.StringValue("service_token")
+		state.ServiceToken = &ServiceTokenModel{
+			ID:          types.StringValue(token.ID),
+			Name:        types.StringValue(token.Name),
+			ProjectId:   types.StringValue(token.Workspace),
+			Environment: types.StringValue(token.Environment),
+			ExpiresAt:   stringOrNull(&token.ExpiresAt),
+		}
+	} else {
+		apiUser, err := d.readUser(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Infisical Current User",
+				err.Error(),
+			)
+			return
+		}
+		user = apiUser
+		state.AuthMethod = types.StringValue("api_key")
+	}
+
+	state.ID = types.StringValue(user.ID)
+	state.Email = stringOrNull(&user.Email)
+	state.FirstName = stringOrNull(&user.FirstName)
+	state.LastName = stringOrNull(&user.LastName)
+	state.PublicKey = stringOrNull(&user.PublicKey)
+	state.CreatedAt = stringOrNull(&user.CreatedAt)
+	state.UpdatedAt = stringOrNull(&user.UpdatedAt)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
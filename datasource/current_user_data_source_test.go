@@ -0,0 +1,27 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+func TestAccCurrentUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + `data "infisical_current_user" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.infisical_current_user.test", "id"),
+					resource.TestCheckResourceAttrSet("data.infisical_current_user.test", "email"),
+					resource.TestCheckResourceAttr("data.infisical_current_user.test", "auth_method", "api_key"),
+					resource.TestCheckNoResourceAttr("data.infisical_current_user.test", "encrypted_private_key"),
+				),
+			},
+		},
+	})
+}
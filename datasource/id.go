@@ -0,0 +1,30 @@
+package datasource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+)
+
+// contentID derives the id of a list data source from the arguments that
+// scope the lookup and the identifiers of the returned objects. The
+// identifiers are sorted, so the id only changes when the result does and
+// dependents see no churn on refresh. List data sources should use it
+// rather than a timestamp.
+func contentID(scope []string, ids []string) string {
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+
+	hash := sha256.New()
+	for _, value := range scope {
+		hash.Write([]byte(value))
+		hash.Write([]byte{0})
+	}
+	hash.Write([]byte{1})
+	for _, id := range sorted {
+		hash.Write([]byte(id))
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,37 @@
+package datasource
+
+import "testing"
+
+func TestContentIDIgnoresOrder(t *testing.T) {
+	a := contentID([]string{"org"}, []string{"p1", "p2", "p3"})
+	b := contentID([]string{"org"}, []string{"p3", "p1", "p2"})
+	if a != b {
+		t.Fatalf("expected the same id for the same result, got %s and %s", a, b)
+	}
+}
+
+func TestContentIDChangesWithResult(t *testing.T) {
+	ids := map[string]string{}
+	for name, id := range map[string]string{
+		"base":          contentID([]string{"org"}, []string{"p1", "p2"}),
+		"other scope":   contentID([]string{"org2"}, []string{"p1", "p2"}),
+		"fewer ids":     contentID([]string{"org"}, []string{"p1"}),
+		"more ids":      contentID([]string{"org"}, []string{"p1", "p2", "p3"}),
+		"shifted scope": contentID([]string{"org", "p1"}, []string{"p2"}),
+		"joined ids":    contentID([]string{"org"}, []string{"p1p2"}),
+		"empty":         contentID(nil, nil),
+	} {
+		if other, ok := ids[id]; ok {
+			t.Fatalf("%s and %s share id %s", name, other, id)
+		}
+		ids[id] = name
+	}
+}
+
+func TestContentIDDoesNotModifyIDs(t *testing.T) {
+	ids := []string{"b", "a"}
+	contentID(nil, ids)
+	if ids[0] != "b" || ids[1] != "a" {
+		t.Fatalf("expected ids to keep their order, got %v", ids)
+	}
+}
@@ -0,0 +1,133 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &IncidentContactsDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentContactsDataSource{}
+)
+
+// NewIncidentContactsDataSource is a helper function to simplify the provider implementation.
+func NewIncidentContactsDataSource() datasource.DataSource {
+	return &IncidentContactsDataSource{}
+}
+
+// IncidentContactsDataSource is the data source implementation.
+type IncidentContactsDataSource struct {
+	client *ic.Session
+}
+
+// IncidentContactsDataSourceModel maps the data source schema data.
+type IncidentContactsDataSourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	OrganizationId types.String   `tfsdk:"organization_id"`
+	Emails         []types.String `tfsdk:"emails"`
+}
+
+// Metadata returns the data source type name.
+func (d *IncidentContactsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident_contacts"
+}
+
+// Schema defines the schema for the data source.
+func (d *IncidentContactsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the incident contacts of an organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Hash of the organization identifier and the contact emails.",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization.",
+				Required:    true,
+			},
+			"emails": schema.ListAttribute{
+				Description: "Email addresses of the incident contacts.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IncidentContactsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type IncidentContactsResponse struct {
+	IncidentContacts []struct {
+		ID    string `json:"_id"`
+		Email string `json:"email"`
+	} `json:"incidentContactsOrg"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *IncidentContactsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state IncidentContactsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.GetApiV1OrganizationOrganizationIdIncidentContactOrg(ctx, state.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Incident Contacts",
+			err.Error(),
+		)
+		return
+	}
+
+	var data IncidentContactsResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Decode Infisical Incident Contacts",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Emails = []types.String{}
+	var ids []string
+	for _, contact := range data.IncidentContacts {
+		state.Emails = append(state.Emails, types.StringValue(contact.Email))
+		ids = append(ids, contact.Email)
+	}
+
+	state.ID = types.StringValue(contentID([]string{state.OrganizationId.ValueString()}, ids))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,33 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var incidentContactsConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_incident_contacts" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+`
+
+func TestAccIncidentContactsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + incidentContactsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infisical_incident_contacts.test", "emails.#", "1"),
+					resource.TestCheckResourceAttrSet("data.infisical_incident_contacts.test", "emails.0"),
+				),
+			},
+		},
+	})
+}
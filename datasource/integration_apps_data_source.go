@@ -0,0 +1,184 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &IntegrationAppsDataSource{}
+	_ datasource.DataSourceWithConfigure = &IntegrationAppsDataSource{}
+)
+
+// NewIntegrationAppsDataSource is a helper function to simplify the provider implementation.
+func NewIntegrationAppsDataSource() datasource.DataSource {
+	return &IntegrationAppsDataSource{}
+}
+
+// IntegrationAppsDataSource is the data source implementation.
+type IntegrationAppsDataSource struct {
+	client *ic.Session
+}
+
+// IntegrationAppsDataSourceModel maps the data source schema data.
+type IntegrationAppsDataSourceModel struct {
+	ID                types.String           `tfsdk:"id"`
+	IntegrationAuthId types.String           `tfsdk:"integration_auth_id"`
+	Name              types.String           `tfsdk:"name"`
+	Apps              []IntegrationAppsModel `tfsdk:"apps"`
+}
+
+// IntegrationAppsModel maps apps schema data.
+type IntegrationAppsModel struct {
+	Name   types.String `tfsdk:"name"`
+	AppId  types.String `tfsdk:"app_id"`
+	SiteId types.String `tfsdk:"site_id"`
+}
+
+// Metadata returns the data source type name.
+func (d *IntegrationAppsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_apps"
+}
+
+// Schema defines the schema for the data source.
+func (d *IntegrationAppsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the apps an integration authorization gives access to on the third-party platform.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Hash of the arguments and the matching apps.",
+				Computed:    true,
+			},
+			"integration_auth_id": schema.StringAttribute{
+				Description: "Identifier of the integration authorization.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return the app with this name.",
+				Optional:    true,
+			},
+			"apps": schema.ListNestedAttribute{
+				Description: "List of apps.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the app, used as app in infisical_integration.",
+							Computed:    true,
+						},
+						"app_id": schema.StringAttribute{
+							Description: "Identifier of the app on the platform, if it has one.",
+							Computed:    true,
+						},
+						"site_id": schema.StringAttribute{
+							Description: "Identifier of the site on the platform, used by Netlify.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IntegrationAppsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type IntegrationAppsResponse struct {
+	Apps []struct {
+		Name   string  `json:"name"`
+		AppId  *string `json:"appId"`
+		SiteId *string `json:"siteId"`
+	} `json:"apps"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *IntegrationAppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state IntegrationAppsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.GetApiV1IntegrationAuthIntegrationAuthIdApps(ctx, state.IntegrationAuthId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Integration Apps",
+			err.Error(),
+		)
+		return
+	}
+
+	var data IntegrationAppsResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Decode Infisical Integration Apps",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Apps = []IntegrationAppsModel{}
+	var ids []string
+	for _, app := range data.Apps {
+		if !state.Name.IsNull() && app.Name != state.Name.ValueString() {
+			continue
+		}
+		ids = append(ids, app.Name+"/"+stringOrNull(app.AppId).ValueString())
+		state.Apps = append(state.Apps, IntegrationAppsModel{
+			Name:   types.StringValue(app.Name),
+			AppId:  stringOrNull(app.AppId),
+			SiteId: stringOrNull(app.SiteId),
+		})
+	}
+
+	if !state.Name.IsNull() && len(state.Apps) == 0 {
+		resp.Diagnostics.AddError(
+			"Infisical Integration App Not Found",
+			fmt.Sprintf("Integration authorization %s has no app named %q.", state.IntegrationAuthId.ValueString(), state.Name.ValueString()),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(contentID([]string{state.IntegrationAuthId.ValueString(), state.Name.ValueString()}, ids))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// stringOrNull maps missing or empty API values to null.
+func stringOrNull(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
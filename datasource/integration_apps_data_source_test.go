@@ -0,0 +1,32 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var integrationAppsConfig = `
+data "infisical_integration_apps" "test" {
+    integration_auth_id = "63c0d1e2f3a4b5c6d7e8f901"
+    name                = "web"
+}
+`
+
+func TestAccIntegrationAppsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + integrationAppsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infisical_integration_apps.test", "apps.#", "1"),
+					resource.TestCheckResourceAttr("data.infisical_integration_apps.test", "apps.0.name", "web"),
+				),
+			},
+		},
+	})
+}
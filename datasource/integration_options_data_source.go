@@ -0,0 +1,170 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &IntegrationOptionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &IntegrationOptionsDataSource{}
+)
+
+// NewIntegrationOptionsDataSource is a helper function to simplify the provider implementation.
+func NewIntegrationOptionsDataSource() datasource.DataSource {
+	return &IntegrationOptionsDataSource{}
+}
+
+// IntegrationOptionsDataSource is the data source implementation.
+type IntegrationOptionsDataSource struct {
+	client *ic.Session
+}
+
+// IntegrationOptionsDataSourceModel maps the data source schema data.
+type IntegrationOptionsDataSourceModel struct {
+	ID                 types.String              `tfsdk:"id"`
+	IntegrationOptions []IntegrationOptionsModel `tfsdk:"integration_options"`
+}
+
+// IntegrationOptionsModel maps integration options schema data.
+type IntegrationOptionsModel struct {
+	Name        types.String `tfsdk:"name"`
+	Slug        types.String `tfsdk:"slug"`
+	Type        types.String `tfsdk:"type"`
+	ClientId    types.String `tfsdk:"client_id"`
+	DocsLink    types.String `tfsdk:"docs_link"`
+	IsAvailable types.Bool   `tfsdk:"is_available"`
+}
+
+// Metadata returns the data source type name.
+func (d *IntegrationOptionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_options"
+}
+
+// Schema defines the schema for the data source.
+func (d *IntegrationOptionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the third-party platforms Infisical can integrate with.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Hash of the integration option slugs.",
+				Computed:    true,
+			},
+			"integration_options": schema.ListNestedAttribute{
+				Description: "List of integration options.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Display name of the platform.",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "Slug of the platform, used as integration in infisical_integration_auth.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Authorization type of the platform, e.g. oauth2.",
+							Computed:    true,
+						},
+						"client_id": schema.StringAttribute{
+							Description: "OAuth client id to start the authorization with.",
+							Computed:    true,
+						},
+						"docs_link": schema.StringAttribute{
+							Description: "Link to the integration documentation.",
+							Computed:    true,
+						},
+						"is_available": schema.BoolAttribute{
+							Description: "Whether the integration is available on this server.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IntegrationOptionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type IntegrationOptionsResponse struct {
+	IntegrationOptions []struct {
+		Name        string `json:"name"`
+		Slug        string `json:"slug"`
+		Type        string `json:"type"`
+		ClientId    string `json:"clientId"`
+		DocsLink    string `json:"docsLink"`
+		IsAvailable bool   `json:"isAvailable"`
+	} `json:"integrationOptions"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *IntegrationOptionsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state IntegrationOptionsDataSourceModel
+
+	res, err := d.client.GetApiV1IntegrationAuthIntegrationOptions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Integration Options",
+			err.Error(),
+		)
+		return
+	}
+
+	var data IntegrationOptionsResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Decode Infisical Integration Options",
+			err.Error(),
+		)
+		return
+	}
+
+	var ids []string
+	for _, option := range data.IntegrationOptions {
+		ids = append(ids, option.Slug)
+		state.IntegrationOptions = append(state.IntegrationOptions, IntegrationOptionsModel{
+			Name:        types.StringValue(option.Name),
+			Slug:        types.StringValue(option.Slug),
+			Type:        types.StringValue(option.Type),
+			ClientId:    types.StringValue(option.ClientId),
+			DocsLink:    types.StringValue(option.DocsLink),
+			IsAvailable: types.BoolValue(option.IsAvailable),
+		})
+	}
+
+	state.ID = types.StringValue(contentID(nil, ids))
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,25 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+func TestAccIntegrationOptionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + `data "infisical_integration_options" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.infisical_integration_options.test", "integration_options.0.slug"),
+					resource.TestCheckResourceAttrSet("data.infisical_integration_options.test", "integration_options.0.name"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,252 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &OrganizationDataSource{}
+	_ datasource.DataSourceWithConfigure      = &OrganizationDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &OrganizationDataSource{}
+)
+
+// NewOrganizationDataSource is a helper function to simplify the provider implementation.
+func NewOrganizationDataSource() datasource.DataSource {
+	return &OrganizationDataSource{}
+}
+
+// OrganizationDataSource is the data source implementation.
+type OrganizationDataSource struct {
+	client *ic.Session
+}
+
+// OrganizationDataSourceModel maps the data source schema data.
+type OrganizationDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	CustomerId           types.String `tfsdk:"customer_id"`
+	ProjectCount         types.Int64  `tfsdk:"project_count"`
+	MemberCount          types.Int64  `tfsdk:"member_count"`
+	IncidentContactCount types.Int64  `tfsdk:"incident_contact_count"`
+}
+
+// Metadata returns the data source type name.
+func (d *OrganizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+// Schema defines the schema for the data source.
+func (d *OrganizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single organization by id, or by name among the organizations of the user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the organization. Conflicts with name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the organization. Conflicts with id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"customer_id": schema.StringAttribute{
+				Description: "Billing customer identifier of the organization.",
+				Computed:    true,
+			},
+			"project_count": schema.Int64Attribute{
+				Description: "Number of projects in the organization the user has access to.",
+				Computed:    true,
+			},
+			"member_count": schema.Int64Attribute{
+				Description: "Number of members of the organization, including pending invitations.",
+				Computed:    true,
+			},
+			"incident_contact_count": schema.Int64Attribute{
+				Description: "Number of incident contacts of the organization.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *OrganizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that the organization is looked up either by id or by name.
+func (d *OrganizationDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var id, name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !id.IsNull() && !name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Conflicting Organization Lookup",
+			"Set either id or name, not both.",
+		)
+	}
+
+	if id.IsNull() && name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Organization Lookup",
+			"Set either id or name.",
+		)
+	}
+}
+
+type OrganizationResponse struct {
+	Organization struct {
+		ID         string `json:"_id"`
+		Name       string `json:"name"`
+		CustomerId string `json:"customerId"`
+	} `json:"organization"`
+}
+
+// findOrganizationId looks up an organization of the user by name, failing
+// unless exactly one organization matches.
+func (d *OrganizationDataSource) findOrganizationId(ctx context.Context, name string) (string, error) {
+	data, err := listOrganizations(ctx, d.client)
+	if err != nil {
+		return "", err
+	}
+
+	var matches []string
+	for _, org := range data.Organizations {
+		if org.Name == name {
+			matches = append(matches, org.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no organization named %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%d organizations named %q, look the organization up by id instead", len(matches), name)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state OrganizationDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := state.ID.ValueString()
+	if state.ID.IsNull() {
+		id, err := d.findOrganizationId(ctx, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Find Infisical Organization",
+				err.Error(),
+			)
+			return
+		}
+		organizationId = id
+	}
+
+	res, err := d.client.GetApiV1OrganizationOrganizationId(ctx, organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Organization",
+			err.Error(),
+		)
+		return
+	}
+
+	var data OrganizationResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		if ic.IsNotFound(err) {
+			err = fmt.Errorf("no organization with id %s", organizationId)
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Organization",
+			err.Error(),
+		)
+		return
+	}
+
+	projects, err := listProjects(ctx, d.client, organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Projects",
+			err.Error(),
+		)
+		return
+	}
+
+	memberships, err := listOrganizationMemberships(ctx, d.client, organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Organization Members",
+			err.Error(),
+		)
+		return
+	}
+
+	res, err = d.client.GetApiV1OrganizationOrganizationIdIncidentContactOrg(ctx, organizationId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Incident Contacts",
+			err.Error(),
+		)
+		return
+	}
+
+	var contacts IncidentContactsResponse
+	if err := ic.DecodeResponse(res, &contacts); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Incident Contacts",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(data.Organization.ID)
+	state.Name = types.StringValue(data.Organization.Name)
+	state.CustomerId = stringOrNull(&data.Organization.CustomerId)
+	state.ProjectCount = types.Int64Value(int64(len(projects.Projects)))
+	state.MemberCount = types.Int64Value(int64(len(memberships)))
+	state.IncidentContactCount = types.Int64Value(int64(len(contacts.IncidentContacts)))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
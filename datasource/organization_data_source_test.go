@@ -0,0 +1,48 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var organizationConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_organization" "by_id" {
+    id = data.infisical_organizations.test.organizations.0.id
+}
+
+data "infisical_organization" "by_name" {
+    name = data.infisical_organizations.test.organizations.0.name
+}
+`
+
+func TestAccOrganizationDataSource(t *testing.T) {
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + organizationConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.infisical_organization.by_id", "name", "data.infisical_organizations.test", "organizations.0.name"),
+					resource.TestCheckResourceAttrPair("data.infisical_organization.by_name", "id", "data.infisical_organization.by_id", "id"),
+					resource.TestCheckResourceAttrSet("data.infisical_organization.by_id", "member_count"),
+					resource.TestCheckResourceAttrSet("data.infisical_organization.by_id", "project_count"),
+					tu.CaptureResourceAttr("data.infisical_organization.by_name", "id", &id),
+				),
+			},
+			// Read testing, the id must be stable across reads
+			{
+				Config: tu.ProviderConfig + organizationConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("data.infisical_organization.by_name", "id", &id),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,278 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &OrganizationMembersDataSource{}
+	_ datasource.DataSourceWithConfigure      = &OrganizationMembersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &OrganizationMembersDataSource{}
+)
+
+// NewOrganizationMembersDataSource is a helper function to simplify the provider implementation.
+func NewOrganizationMembersDataSource() datasource.DataSource {
+	return &OrganizationMembersDataSource{}
+}
+
+// OrganizationMembersDataSource is the data source implementation.
+type OrganizationMembersDataSource struct {
+	client *ic.Session
+}
+
+// OrganizationMembersDataSourceModel maps the data source schema data.
+type OrganizationMembersDataSourceModel struct {
+	ID             types.String               `tfsdk:"id"`
+	OrganizationId types.String               `tfsdk:"organization_id"`
+	Role           types.String               `tfsdk:"role"`
+	Status         types.String               `tfsdk:"status"`
+	EmailRegex     types.String               `tfsdk:"email_regex"`
+	Members        []OrganizationMembersModel `tfsdk:"members"`
+}
+
+// OrganizationMembersModel maps members schema data.
+type OrganizationMembersModel struct {
+	ID        types.String `tfsdk:"id"`
+	UserId    types.String `tfsdk:"user_id"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	PublicKey types.String `tfsdk:"public_key"`
+	Role      types.String `tfsdk:"role"`
+	Status    types.String `tfsdk:"status"`
+}
+
+// Metadata returns the data source type name.
+func (d *OrganizationMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_members"
+}
+
+// Schema defines the schema for the data source.
+func (d *OrganizationMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the members of an organization, including pending invitations.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Hash of the arguments and the matching membership identifiers.",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization.",
+				Required:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Only return members with this role, e.g. owner, admin or member.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only return members with this status, e.g. invited, verified or accepted.",
+				Optional:    true,
+			},
+			"email_regex": schema.StringAttribute{
+				Description: "Only return members whose email address matches this regular expression.",
+				Optional:    true,
+			},
+			"members": schema.ListNestedAttribute{
+				Description: "List of members.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the membership.",
+							Computed:    true,
+						},
+						"user_id": schema.StringAttribute{
+							Description: "Identifier of the user, null until the invitation is accepted.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "Email address of the member.",
+							Computed:    true,
+						},
+						"first_name": schema.StringAttribute{
+							Description: "First name of the member.",
+							Computed:    true,
+						},
+						"last_name": schema.StringAttribute{
+							Description: "Last name of the member.",
+							Computed:    true,
+						},
+						"public_key": schema.StringAttribute{
+							Description: "Public key of the member.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the member.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the membership.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *OrganizationMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that email_regex compiles.
+func (d *OrganizationMembersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	validateRegexAttribute(ctx, req.Config, path.Root("email_regex"), &resp.Diagnostics)
+}
+
+type OrganizationMembershipsResponse struct {
+	Memberships []OrganizationMembership `json:"memberships"`
+}
+
+type OrganizationUsersResponse struct {
+	Users []OrganizationMembership `json:"users"`
+}
+
+type OrganizationMembership struct {
+	ID          string `json:"_id"`
+	Role        string `json:"role"`
+	Status      string `json:"status"`
+	InviteEmail string `json:"inviteEmail"`
+	User        *struct {
+		ID        string `json:"_id"`
+		Email     string `json:"email"`
+		FirstName string `json:"firstName"`
+		LastName  string `json:"lastName"`
+		PublicKey string `json:"publicKey"`
+	} `json:"user"`
+}
+
+// listOrganizationMemberships reads the v2 memberships, falling back to the v1 users
+// endpoint on servers without it.
+func listOrganizationMemberships(ctx context.Context, client *ic.Session, organizationId string) ([]OrganizationMembership, error) {
+	if client.Supports(ic.APIv2) {
+		res, err := client.GetApiV2OrganizationsOrganizationIdMemberships(ctx, organizationId)
+		if err != nil {
+			return nil, err
+		}
+
+		var data OrganizationMembershipsResponse
+		err = ic.DecodeResponse(res, &data)
+		if !ic.IsNotFound(err) {
+			return data.Memberships, err
+		}
+	}
+
+	res, err := client.GetApiV1OrganizationOrganizationIdUsers(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var users OrganizationUsersResponse
+	if err := ic.DecodeResponse(res, &users); err != nil {
+		return nil, err
+	}
+
+	return users.Users, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *OrganizationMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state OrganizationMembersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var emailRegex *regexp.Regexp
+	if !state.EmailRegex.IsNull() {
+		emailRegex = regexp.MustCompile(state.EmailRegex.ValueString())
+	}
+
+	memberships, err := listOrganizationMemberships(ctx, d.client, state.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Organization Members",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Members = []OrganizationMembersModel{}
+	var ids []string
+	for _, membership := range memberships {
+		member := OrganizationMembersModel{
+			ID:        types.StringValue(membership.ID),
+			UserId:    types.StringNull(),
+			Email:     stringOrNull(&membership.InviteEmail),
+			FirstName: types.StringNull(),
+			LastName:  types.StringNull(),
+			PublicKey: types.StringNull(),
+			Role:      types.StringValue(membership.Role),
+			Status:    types.StringValue(membership.Status),
+		}
+		if membership.User != nil {
+			member.UserId = types.StringValue(membership.User.ID)
+			member.FirstName = stringOrNull(&membership.User.FirstName)
+			member.LastName = stringOrNull(&membership.User.LastName)
+			member.PublicKey = stringOrNull(&membership.User.PublicKey)
+			if membership.User.Email != "" {
+				member.Email = types.StringValue(membership.User.Email)
+			}
+		}
+
+		if !state.Role.IsNull() && membership.Role != state.Role.ValueString() {
+			continue
+		}
+		if !state.Status.IsNull() && membership.Status != state.Status.ValueString() {
+			continue
+		}
+		if emailRegex != nil && !emailRegex.MatchString(member.Email.ValueString()) {
+			continue
+		}
+
+		state.Members = append(state.Members, member)
+		ids = append(ids, membership.ID)
+	}
+
+	state.ID = types.StringValue(contentID([]string{
+		state.OrganizationId.ValueString(),
+		state.Role.ValueString(),
+		state.Status.ValueString(),
+		state.EmailRegex.ValueString(),
+	}, ids))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,34 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var organizationMembersConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_organization_members" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+    role            = "owner"
+}
+`
+
+func TestAccOrganizationMembersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + organizationMembersConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infisical_organization_members.test", "members.0.role", "owner"),
+					resource.TestCheckResourceAttrSet("data.infisical_organization_members.test", "members.0.user_id"),
+				),
+			},
+		},
+	})
+}
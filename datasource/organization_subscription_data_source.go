@@ -0,0 +1,283 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &OrganizationSubscriptionDataSource{}
+	_ datasource.DataSourceWithConfigure = &OrganizationSubscriptionDataSource{}
+)
+
+// freeTier is reported when an organization has no current subscription.
+const freeTier = "free"
+
+// NewOrganizationSubscriptionDataSource is a helper function to simplify the provider implementation.
+func NewOrganizationSubscriptionDataSource() datasource.DataSource {
+	return &OrganizationSubscriptionDataSource{}
+}
+
+// OrganizationSubscriptionDataSource is the data source implementation.
+type OrganizationSubscriptionDataSource struct {
+	client *ic.Session
+}
+
+// OrganizationSubscriptionDataSourceModel maps the data source schema data.
+type OrganizationSubscriptionDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	OrganizationId    types.String `tfsdk:"organization_id"`
+	SubscriptionId    types.String `tfsdk:"subscription_id"`
+	Tier              types.String `tfsdk:"tier"`
+	IsPaid            types.Bool   `tfsdk:"is_paid"`
+	Status            types.String `tfsdk:"status"`
+	Interval          types.String `tfsdk:"interval"`
+	Seats             types.Int64  `tfsdk:"seats"`
+	MemberLimit       types.Int64  `tfsdk:"member_limit"`
+	EnvironmentLimit  types.Int64  `tfsdk:"environment_limit"`
+	CurrentPeriodEnd  types.String `tfsdk:"current_period_end"`
+	TrialEnd          types.String `tfsdk:"trial_end"`
+	CancelAtPeriodEnd types.Bool   `tfsdk:"cancel_at_period_end"`
+}
+
+// Metadata returns the data source type name.
+func (d *OrganizationSubscriptionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_subscription"
+}
+
+// Schema defines the schema for the data source.
+func (d *OrganizationSubscriptionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the current subscription of an organization. Organizations without a subscription " +
+			"are reported on the free tier.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the organization.",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization.",
+				Required:    true,
+			},
+			"subscription_id": schema.StringAttribute{
+				Description: "Identifier of the subscription, null on the free tier.",
+				Computed:    true,
+			},
+			"tier": schema.StringAttribute{
+				Description: "Lower case name of the plan, e.g. free, starter, team or pro.",
+				Computed:    true,
+			},
+			"is_paid": schema.BoolAttribute{
+				Description: "Whether the organization is on a paid plan, including trials.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the subscription, e.g. active, trialing or past_due, null on the free tier.",
+				Computed:    true,
+			},
+			"interval": schema.StringAttribute{
+				Description: "Billing interval of the plan, either month or year.",
+				Computed:    true,
+			},
+			"seats": schema.Int64Attribute{
+				Description: "Number of seats paid for, 0 on the free tier.",
+				Computed:    true,
+			},
+			"member_limit": schema.Int64Attribute{
+				Description: "Maximum number of members, from the memberLimit plan metadata. Null when the plan sets no limit.",
+				Computed:    true,
+			},
+			"environment_limit": schema.Int64Attribute{
+				Description: "Maximum number of environments per project, from the environmentLimit plan metadata. Null when the plan sets no limit.",
+				Computed:    true,
+			},
+			"current_period_end": schema.StringAttribute{
+				Description: "Time the current billing period ends.",
+				Computed:    true,
+			},
+			"trial_end": schema.StringAttribute{
+				Description: "Time the trial ends, null when the subscription is not trialing.",
+				Computed:    true,
+			},
+			"cancel_at_period_end": schema.BoolAttribute{
+				Description: "Whether the subscription is cancelled at the end of the current period.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *OrganizationSubscriptionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// SubscriptionsResponse holds the Stripe subscriptions of an organization.
+type SubscriptionsResponse struct {
+	Subscriptions []Subscription `json:"subscriptions"`
+}
+
+type Subscription struct {
+	ID                string `json:"id"`
+	Status            string `json:"status"`
+	CancelAtPeriodEnd bool   `json:"cancel_at_period_end"`
+	CurrentPeriodEnd  int64  `json:"current_period_end"`
+	TrialEnd          *int64 `json:"trial_end"`
+	Items             struct {
+		Data []struct {
+			Quantity int64 `json:"quantity"`
+			Plan     struct {
+				Nickname string            `json:"nickname"`
+				Product  string            `json:"product"`
+				Interval string            `json:"interval"`
+				Amount   int64             `json:"amount"`
+				Metadata map[string]string `json:"metadata"`
+			} `json:"plan"`
+		} `json:"data"`
+	} `json:"items"`
+}
+
+// current reports whether the subscription still grants its plan.
+func (s *Subscription) current() bool {
+	switch s.Status {
+	case "active", "trialing", "past_due":
+		return true
+	}
+	return false
+}
+
+// unixOrNull formats a Unix timestamp as RFC 3339, mapping missing values to null.
+func unixOrNull(value *int64) types.String {
+	if value == nil || *value == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Unix(*value, 0).UTC().Format(time.RFC3339))
+}
+
+// limitOrNull parses a limit from plan metadata, mapping missing values to null.
+func limitOrNull(metadata map[string]string, key string) (types.Int64, error) {
+	value, ok := metadata[key]
+	if !ok || value == "" {
+		return types.Int64Null(), nil
+	}
+	limit, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return types.Int64Null(), fmt.Errorf("plan metadata %s is not a number: %q", key, value)
+	}
+	return types.Int64Value(limit), nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *OrganizationSubscriptionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state OrganizationSubscriptionDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.GetApiV1OrganizationOrganizationIdSubscriptions(ctx, state.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Organization Subscription",
+			err.Error(),
+		)
+		return
+	}
+
+	var data SubscriptionsResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Organization Subscription",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = state.OrganizationId
+	state.SubscriptionId = types.StringNull()
+	state.Tier = types.StringValue(freeTier)
+	state.IsPaid = types.BoolValue(false)
+	state.Status = types.StringNull()
+	state.Interval = types.StringNull()
+	state.Seats = types.Int64Value(0)
+	state.MemberLimit = types.Int64Null()
+	state.EnvironmentLimit = types.Int64Null()
+	state.CurrentPeriodEnd = types.StringNull()
+	state.TrialEnd = types.StringNull()
+	state.CancelAtPeriodEnd = types.BoolValue(false)
+
+	for i := range data.Subscriptions {
+		subscription := &data.Subscriptions[i]
+		if !subscription.current() || len(subscription.Items.Data) == 0 {
+			continue
+		}
+
+		plan := subscription.Items.Data[0].Plan
+		tier := plan.Nickname
+		if tier == "" {
+			tier = plan.Product
+		}
+
+		var seats int64
+		for _, item := range subscription.Items.Data {
+			seats += item.Quantity
+		}
+
+		memberLimit, err := limitOrNull(plan.Metadata, "memberLimit")
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Infisical Organization Subscription", err.Error())
+			return
+		}
+		environmentLimit, err := limitOrNull(plan.Metadata, "environmentLimit")
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read Infisical Organization Subscription", err.Error())
+			return
+		}
+
+		state.SubscriptionId = types.StringValue(subscription.ID)
+		state.Tier = types.StringValue(strings.ToLower(tier))
+		state.IsPaid = types.BoolValue(plan.Amount > 0 || subscription.Status == "trialing")
+		state.Status = types.StringValue(subscription.Status)
+		state.Interval = stringOrNull(&plan.Interval)
+		state.Seats = types.Int64Value(seats)
+		state.MemberLimit = memberLimit
+		state.EnvironmentLimit = environmentLimit
+		state.CurrentPeriodEnd = unixOrNull(&subscription.CurrentPeriodEnd)
+		state.TrialEnd = unixOrNull(subscription.TrialEnd)
+		state.CancelAtPeriodEnd = types.BoolValue(subscription.CancelAtPeriodEnd)
+		break
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,34 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var organizationSubscriptionConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_organization_subscription" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+`
+
+func TestAccOrganizationSubscriptionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + organizationSubscriptionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.infisical_organization_subscription.test", "id", "data.infisical_organizations.test", "organizations.0.id"),
+					resource.TestCheckResourceAttrSet("data.infisical_organization_subscription.test", "tier"),
+					resource.TestCheckResourceAttrSet("data.infisical_organization_subscription.test", "is_paid"),
+				),
+			},
+		},
+	})
+}
@@ -2,14 +2,14 @@ package datasource
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
-	"strconv"
-	"time"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	ic "github.com/asheliahut/terraform-provider-infisical/client"
@@ -17,8 +17,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &OrganizationsDataSource{}
-	_ datasource.DataSourceWithConfigure = &OrganizationsDataSource{}
+	_ datasource.DataSource                   = &OrganizationsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &OrganizationsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &OrganizationsDataSource{}
 )
 
 // NewOrganizationsDataSource is a helper function to simplify the provider implementation.
@@ -28,13 +29,16 @@ func NewOrganizationsDataSource() datasource.DataSource {
 
 // OrganizationsDataSource is the data source implementation.
 type OrganizationsDataSource struct {
-	client *ic.Client
+	client *ic.Session
 }
 
 // OrganizationsDataSourceModel maps the data source schema data.
 type OrganizationsDataSourceModel struct {
 	Organizations []OrganizationsModel `tfsdk:"organizations"`
 	ID            types.String         `tfsdk:"id"`
+	Name          types.String         `tfsdk:"name"`
+	NameRegex     types.String         `tfsdk:"name_regex"`
+	IDs           []types.String       `tfsdk:"ids"`
 }
 
 // OrganizationsModel maps organizations schema data.
@@ -51,12 +55,25 @@ func (d *OrganizationsDataSource) Metadata(_ context.Context, req datasource.Met
 // Schema defines the schema for the data source.
 func (d *OrganizationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches the list of organizations.",
+		Description: "Fetches the list of organizations, optionally filtered by name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Current Unix timestamp for id.",
+				Description: "Hash of the arguments and the matching organization identifiers.",
 				Computed:    true,
 			},
+			"name": schema.StringAttribute{
+				Description: "Only return organizations with exactly this name.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return organizations whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "Identifiers of the matching organizations.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"organizations": schema.ListNestedAttribute{
 				Description: "List of organizations.",
 				Computed:    true,
@@ -83,12 +100,12 @@ func (d *OrganizationsDataSource) Configure(_ context.Context, req datasource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*ic.Client)
+	client, ok := req.ProviderData.(*ic.Session)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -97,6 +114,51 @@ func (d *OrganizationsDataSource) Configure(_ context.Context, req datasource.Co
 	d.client = client
 }
 
+// ValidateConfig checks that name_regex compiles.
+func (d *OrganizationsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	validateRegexAttribute(ctx, req.Config, path.Root("name_regex"), &resp.Diagnostics)
+}
+
+// validateRegexAttribute reports an attribute error when the string at
+// attribute is set but is not a valid regular expression.
+func validateRegexAttribute(ctx context.Context, config tfsdk.Config, attribute path.Path, diags *diag.Diagnostics) {
+	var value types.String
+	diags.Append(config.GetAttribute(ctx, attribute, &value)...)
+	if diags.HasError() || value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(value.ValueString()); err != nil {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid Regular Expression",
+			err.Error(),
+		)
+	}
+}
+
+// nameFilter matches names against the optional name and name_regex
+// attributes, which have been validated by validateRegexAttribute.
+type nameFilter struct {
+	name  types.String
+	regex *regexp.Regexp
+}
+
+func newNameFilter(name types.String, nameRegex types.String) nameFilter {
+	filter := nameFilter{name: name}
+	if !nameRegex.IsNull() {
+		filter.regex = regexp.MustCompile(nameRegex.ValueString())
+	}
+	return filter
+}
+
+func (f nameFilter) matches(name string) bool {
+	if !f.name.IsNull() && f.name.ValueString() != name {
+		return false
+	}
+	return f.regex == nil || f.regex.MatchString(name)
+}
+
 type MyOrganizationsResponse struct {
 	Organizations []struct {
 		ID   string `json:"_id"`
@@ -104,50 +166,74 @@ type MyOrganizationsResponse struct {
 	} `json:"organizations"`
 }
 
-// Read refreshes the Terraform state with the latest data.
-func (d *OrganizationsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state OrganizationsDataSourceModel
+// listOrganizations reads the organizations of the user from the v2
+// endpoint, falling back to v1 on servers without it.
+func listOrganizations(ctx context.Context, client *ic.Session) (*MyOrganizationsResponse, error) {
+	var data MyOrganizationsResponse
+	if client.Supports(ic.APIv2) {
+		res, err := client.GetApiV2UsersMeOrganizations(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ic.DecodeResponse(res, &data)
+		if !ic.IsNotFound(err) {
+			return &data, err
+		}
+	}
 
-	res, err := d.client.GetApiV2UsersMeOrganizations(ctx)
+	res, err := client.GetApiV1Organization(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Infisical Organizations for User",
-			err.Error(),
-		)
-		return
+		return nil, err
 	}
 
-	defer func(Body io.ReadCloser) {
-		err := Body.Close()
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to close body reader for User",
-				err.Error(),
-			)
-			return
-		}
-	}(res.Body)
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
 
-	var data MyOrganizationsResponse
-	if err := json.NewDecoder(res.Body).Decode(&data); err != nil && err != io.EOF {
+	return &data, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state OrganizationsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := listOrganizations(ctx, d.client)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Decode Infisical Organizations for User",
+			"Unable to Read Infisical Organizations for User",
 			err.Error(),
 		)
 		return
 	}
 
+	filter := newNameFilter(state.Name, state.NameRegex)
+	state.Organizations = []OrganizationsModel{}
+	state.IDs = []types.String{}
 	for _, org := range data.Organizations {
+		if !filter.matches(org.Name) {
+			continue
+		}
 		state.Organizations = append(state.Organizations, OrganizationsModel{
 			ID:   types.StringValue(org.ID),
 			Name: types.StringValue(org.Name),
 		})
+		state.IDs = append(state.IDs, types.StringValue(org.ID))
 	}
 
-	state.ID = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
+	var ids []string
+	for _, id := range state.IDs {
+		ids = append(ids, id.ValueString())
+	}
+	state.ID = types.StringValue(contentID([]string{state.Name.ValueString(), state.NameRegex.ValueString()}, ids))
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -9,6 +9,7 @@ import (
 )
 
 func TestAccOrganizationsDataSource(t *testing.T) {
+	var id string
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -21,6 +22,22 @@ func TestAccOrganizationsDataSource(t *testing.T) {
 					// Verify the first coffee to ensure all attributes are set
 					resource.TestCheckResourceAttr("data.infisical_organizations.test", "organizations.0.id", ""),
 					resource.TestCheckResourceAttr("data.infisical_organizations.test", "organizations.0.name", ""),
+					tu.CaptureResourceAttr("data.infisical_organizations.test", "id", &id),
+				),
+			},
+			// Read testing, the id must be stable across reads
+			{
+				Config: tu.ProviderConfig + `data "infisical_organizations" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("data.infisical_organizations.test", "id", &id),
+				),
+			},
+			// Read testing
+			{
+				Config: tu.ProviderConfig + `data "infisical_organizations" "test" { name_regex = "^does-not-exist-" }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infisical_organizations.test", "organizations.#", "0"),
+					resource.TestCheckResourceAttr("data.infisical_organizations.test", "ids.#", "0"),
 				),
 			},
 		},
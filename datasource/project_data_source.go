@@ -0,0 +1,316 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &ProjectDataSource{}
+	_ datasource.DataSourceWithConfigure      = &ProjectDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ProjectDataSource{}
+)
+
+// NewProjectDataSource is a helper function to simplify the provider implementation.
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+// ProjectDataSource is the data source implementation.
+type ProjectDataSource struct {
+	client *ic.Session
+}
+
+// ProjectDataSourceModel maps the data source schema data.
+type ProjectDataSourceModel struct {
+	ID               types.String              `tfsdk:"id"`
+	Name             types.String              `tfsdk:"name"`
+	OrganizationId   types.String              `tfsdk:"organization_id"`
+	Environments     []ProjectEnvironmentModel `tfsdk:"environments"`
+	MemberCount      types.Int64               `tfsdk:"member_count"`
+	IntegrationCount types.Int64               `tfsdk:"integration_count"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProjectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// Schema defines the schema for the data source.
+func (d *ProjectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single project by id, or by name within an organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the project. Conflicts with name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the project. Requires organization_id and conflicts with id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization of the project.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"environments": schema.ListNestedAttribute{
+				Description: "List of environments.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the environment.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the environment.",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "Slug of the environment.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"member_count": schema.Int64Attribute{
+				Description: "Number of members of the project.",
+				Computed:    true,
+			},
+			"integration_count": schema.Int64Attribute{
+				Description: "Number of integrations of the project.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProjectDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that the project is looked up either by id or by
+// name within an organization.
+func (d *ProjectDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var id, name, organizationId types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("organization_id"), &organizationId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !id.IsNull() && !name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Conflicting Project Lookup",
+			"Set either id or name, not both.",
+		)
+		return
+	}
+
+	if id.IsNull() && name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Project Lookup",
+			"Set either id, or name together with organization_id.",
+		)
+		return
+	}
+
+	if !name.IsNull() && organizationId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("organization_id"),
+			"Missing Organization",
+			"Looking up a project by name requires organization_id.",
+		)
+	}
+}
+
+type ProjectUsersResponse struct {
+	Users []json.RawMessage `json:"users"`
+}
+
+type ProjectIntegrationsResponse struct {
+	Integrations []json.RawMessage `json:"integrations"`
+}
+
+// findProject looks up a project by name within an organization, failing
+// unless exactly one project matches.
+func (d *ProjectDataSource) findProject(ctx context.Context, organizationId string, name string) (*ic.Workspace, error) {
+	data, err := listProjects(ctx, d.client, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []ic.Workspace
+	for _, proj := range data.Projects {
+		if proj.Name != name {
+			continue
+		}
+		workspace := ic.Workspace{ID: proj.ID, Name: proj.Name, Organization: proj.Organization}
+		if workspace.Organization == "" {
+			workspace.Organization = organizationId
+		}
+		for _, env := range proj.Environments {
+			workspace.Environments = append(workspace.Environments, ic.WorkspaceEnvironment{ID: env.ID, Name: env.Name, Slug: env.Slug})
+		}
+		matches = append(matches, workspace)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no project named %q in organization %s", name, organizationId)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d projects named %q in organization %s, look the project up by id instead", len(matches), name, organizationId)
+	}
+}
+
+// countMembers counts the members of a project, falling back to the v1
+// users endpoint on servers without the v2 memberships.
+func (d *ProjectDataSource) countMembers(ctx context.Context, workspaceId string) (int, error) {
+	if d.client.Supports(ic.APIv2) {
+		res, err := d.client.GetApiV2WorkspaceWorkspaceIdMemberships(ctx, workspaceId)
+		if err != nil {
+			return 0, err
+		}
+
+		var data ProjectMembershipsResponse
+		err = ic.DecodeResponse(res, &data)
+		if !ic.IsNotFound(err) {
+			return len(data.Memberships), err
+		}
+	}
+
+	res, err := d.client.GetApiV1WorkspaceWorkspaceIdUsers(ctx, workspaceId)
+	if err != nil {
+		return 0, err
+	}
+
+	var data ProjectUsersResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		return 0, err
+	}
+
+	return len(data.Users), nil
+}
+
+func (d *ProjectDataSource) countIntegrations(ctx context.Context, workspaceId string) (int, error) {
+	res, err := d.client.GetApiV1WorkspaceWorkspaceIdIntegrations(ctx, workspaceId)
+	if err != nil {
+		return 0, err
+	}
+
+	var data ProjectIntegrationsResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		return 0, err
+	}
+
+	return len(data.Integrations), nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ProjectDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var workspace *ic.Workspace
+	var err error
+	if !state.ID.IsNull() {
+		workspace, err = d.client.GetWorkspace(ctx, state.ID.ValueString())
+		if ic.IsNotFound(err) {
+			err = fmt.Errorf("no project with id %s", state.ID.ValueString())
+		}
+	} else {
+		workspace, err = d.findProject(ctx, state.OrganizationId.ValueString(), state.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Find Infisical Project",
+			err.Error(),
+		)
+		return
+	}
+
+	if !state.OrganizationId.IsNull() && workspace.Organization != "" && workspace.Organization != state.OrganizationId.ValueString() {
+		resp.Diagnostics.AddError(
+			"Unable to Find Infisical Project",
+			fmt.Sprintf("Project %s belongs to organization %s, not %s.", workspace.ID, workspace.Organization, state.OrganizationId.ValueString()),
+		)
+		return
+	}
+
+	memberCount, err := d.countMembers(ctx, workspace.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Project Members",
+			err.Error(),
+		)
+		return
+	}
+
+	integrationCount, err := d.countIntegrations(ctx, workspace.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Project Integrations",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(workspace.ID)
+	state.Name = types.StringValue(workspace.Name)
+	if workspace.Organization != "" {
+		state.OrganizationId = types.StringValue(workspace.Organization)
+	}
+	state.Environments = []ProjectEnvironmentModel{}
+	for _, env := range workspace.Environments {
+		state.Environments = append(state.Environments, ProjectEnvironmentModel{
+			ID:   stringOrNull(&env.ID),
+			Name: types.StringValue(env.Name),
+			Slug: types.StringValue(env.Slug),
+		})
+	}
+	state.MemberCount = types.Int64Value(int64(memberCount))
+	state.IntegrationCount = types.Int64Value(int64(integrationCount))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,44 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var projectConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+data "infisical_project" "by_id" {
+    id = data.infisical_projects.test.projects.0.id
+}
+
+data "infisical_project" "by_name" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+    name            = data.infisical_project.by_id.name
+}
+`
+
+func TestAccProjectDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + projectConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.infisical_project.by_id", "id", "data.infisical_projects.test", "projects.0.id"),
+					resource.TestCheckResourceAttrPair("data.infisical_project.by_name", "id", "data.infisical_project.by_id", "id"),
+					resource.TestCheckResourceAttrSet("data.infisical_project.by_id", "member_count"),
+					resource.TestCheckResourceAttrSet("data.infisical_project.by_id", "environments.0.slug"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,214 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &ProjectEnvironmentDataSource{}
+	_ datasource.DataSourceWithConfigure      = &ProjectEnvironmentDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ProjectEnvironmentDataSource{}
+)
+
+// NewProjectEnvironmentDataSource is a helper function to simplify the provider implementation.
+func NewProjectEnvironmentDataSource() datasource.DataSource {
+	return &ProjectEnvironmentDataSource{}
+}
+
+// ProjectEnvironmentDataSource is the data source implementation.
+type ProjectEnvironmentDataSource struct {
+	client *ic.Session
+}
+
+// ProjectEnvironmentDataSourceModel maps the data source schema data.
+type ProjectEnvironmentDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProjectId    types.String `tfsdk:"project_id"`
+	Name         types.String `tfsdk:"name"`
+	Slug         types.String `tfsdk:"slug"`
+	SecretCount  types.Int64  `tfsdk:"secret_count"`
+	LastModified types.String `tfsdk:"last_modified"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProjectEnvironmentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_environment"
+}
+
+// Schema defines the schema for the data source.
+func (d *ProjectEnvironmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single environment of a project by slug or name, with statistics about its secrets.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the environment, or project_id/slug on servers that do not return one.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the environment. Conflicts with slug.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"slug": schema.StringAttribute{
+				Description: "Slug of the environment. Conflicts with name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"secret_count": schema.Int64Attribute{
+				Description: "Number of shared secrets in the environment.",
+				Computed:    true,
+			},
+			"last_modified": schema.StringAttribute{
+				Description: "Time a secret in the environment was last created or updated, null when it has no secrets.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProjectEnvironmentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that the environment is looked up either by slug or by name.
+func (d *ProjectEnvironmentDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var name, slug types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("slug"), &slug)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !name.IsNull() && !slug.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Conflicting Environment Lookup",
+			"Set either slug or name, not both.",
+		)
+	}
+
+	if name.IsNull() && slug.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Environment Lookup",
+			"Set either slug or name.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProjectEnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ProjectEnvironmentDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace, err := d.client.GetWorkspace(ctx, state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Project",
+			err.Error(),
+		)
+		return
+	}
+
+	var environment *ic.WorkspaceEnvironment
+	var slugs []string
+	for i, env := range workspace.Environments {
+		slugs = append(slugs, env.Slug)
+		if (!state.Slug.IsNull() && env.Slug == state.Slug.ValueString()) ||
+			(!state.Name.IsNull() && env.Name == state.Name.ValueString()) {
+			environment = &workspace.Environments[i]
+		}
+	}
+	if environment == nil {
+		lookup := fmt.Sprintf("slug %q", state.Slug.ValueString())
+		if state.Slug.IsNull() {
+			lookup = fmt.Sprintf("name %q", state.Name.ValueString())
+		}
+		resp.Diagnostics.AddError(
+			"Infisical Environment Not Found",
+			fmt.Sprintf("Project %s has no environment with %s. Available slugs: %s.", workspace.ID, lookup, strings.Join(slugs, ", ")),
+		)
+		return
+	}
+
+	secrets, err := d.client.ListEncryptedSecrets(ctx, workspace.ID, environment.Slug)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Secrets",
+			err.Error(),
+		)
+		return
+	}
+
+	// Personal secrets are only returned to their owner, so only shared
+	// secrets are counted to keep the result independent of the caller.
+	var count int64
+	var lastModified time.Time
+	for _, secret := range secrets {
+		if secret.Type != "shared" {
+			continue
+		}
+		count++
+
+		for _, value := range []string{secret.CreatedAt, secret.UpdatedAt} {
+			modified, err := time.Parse(time.RFC3339, value)
+			if err == nil && modified.After(lastModified) {
+				lastModified = modified
+			}
+		}
+	}
+
+	// Older servers do not return environment identifiers.
+	state.ID = types.StringValue(environment.ID)
+	if environment.ID == "" {
+		state.ID = types.StringValue(workspace.ID + "/" + environment.Slug)
+	}
+	state.Name = types.StringValue(environment.Name)
+	state.Slug = types.StringValue(environment.Slug)
+	state.SecretCount = types.Int64Value(count)
+	state.LastModified = types.StringNull()
+	if !lastModified.IsZero() {
+		state.LastModified = types.StringValue(lastModified.UTC().Format(time.RFC3339))
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
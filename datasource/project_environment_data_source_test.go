@@ -0,0 +1,67 @@
+package datasource_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var projectEnvironmentConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+data "infisical_project_environment" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+    slug       = data.infisical_projects.test.projects.0.environments.0.slug
+}
+`
+
+var projectEnvironmentMissingConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+data "infisical_project_environment" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+    slug       = "does-not-exist"
+}
+`
+
+func TestAccProjectEnvironmentDataSource(t *testing.T) {
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + projectEnvironmentConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.infisical_project_environment.test", "slug", "data.infisical_projects.test", "projects.0.environments.0.slug"),
+					resource.TestCheckResourceAttrPair("data.infisical_project_environment.test", "name", "data.infisical_projects.test", "projects.0.environments.0.name"),
+					resource.TestCheckResourceAttrSet("data.infisical_project_environment.test", "secret_count"),
+					tu.CaptureResourceAttr("data.infisical_project_environment.test", "id", &id),
+				),
+			},
+			// Read testing, the id must be stable across reads
+			{
+				Config: tu.ProviderConfig + projectEnvironmentConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("data.infisical_project_environment.test", "id", &id),
+				),
+			},
+			// Read testing, unknown slugs fail
+			{
+				Config:      tu.ProviderConfig + projectEnvironmentMissingConfig,
+				ExpectError: regexp.MustCompile("Infisical Environment Not Found"),
+			},
+		},
+	})
+}
@@ -0,0 +1,254 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ProjectMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &ProjectMembersDataSource{}
+)
+
+// NewProjectMembersDataSource is a helper function to simplify the provider implementation.
+func NewProjectMembersDataSource() datasource.DataSource {
+	return &ProjectMembersDataSource{}
+}
+
+// ProjectMembersDataSource is the data source implementation.
+type ProjectMembersDataSource struct {
+	client *ic.Session
+}
+
+// ProjectMembersDataSourceModel maps the data source schema data.
+type ProjectMembersDataSourceModel struct {
+	ID                      types.String          `tfsdk:"id"`
+	ProjectId               types.String          `tfsdk:"project_id"`
+	Members                 []ProjectMembersModel `tfsdk:"members"`
+	MissingPublicKeyUserIds []types.String        `tfsdk:"missing_public_key_user_ids"`
+}
+
+// ProjectMembersModel maps members schema data.
+type ProjectMembersModel struct {
+	ID           types.String `tfsdk:"id"`
+	UserId       types.String `tfsdk:"user_id"`
+	Email        types.String `tfsdk:"email"`
+	FirstName    types.String `tfsdk:"first_name"`
+	LastName     types.String `tfsdk:"last_name"`
+	PublicKey    types.String `tfsdk:"public_key"`
+	Role         types.String `tfsdk:"role"`
+	HasPublicKey types.Bool   `tfsdk:"has_public_key"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProjectMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_members"
+}
+
+// Schema defines the schema for the data source.
+func (d *ProjectMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the members of a project and whether they have a public key. " +
+			"The project key can only be shared with members who have a public key, so members without one, such as " +
+			"pending invitations, cannot decrypt the project's secrets. Whether the key was actually shared with a " +
+			"member cannot be read from the API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+			},
+			"members": schema.ListNestedAttribute{
+				Description: "List of members.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the membership.",
+							Computed:    true,
+						},
+						"user_id": schema.StringAttribute{
+							Description: "Identifier of the user.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "Email address of the member.",
+							Computed:    true,
+						},
+						"first_name": schema.StringAttribute{
+							Description: "First name of the member.",
+							Computed:    true,
+						},
+						"last_name": schema.StringAttribute{
+							Description: "Last name of the member.",
+							Computed:    true,
+						},
+						"public_key": schema.StringAttribute{
+							Description: "Public key of the member.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the member in the project.",
+							Computed:    true,
+						},
+						"has_public_key": schema.BoolAttribute{
+							Description: "Whether the member has a public key listed by the project keys endpoint, which the project key can be shared with.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"missing_public_key_user_ids": schema.ListAttribute{
+				Description: "Identifiers of the members without a public key, who cannot have received the project key.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProjectMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type ProjectMembershipsResponse struct {
+	Memberships []ProjectMembership `json:"memberships"`
+}
+
+type ProjectMembership struct {
+	ID   string `json:"_id"`
+	Role string `json:"role"`
+	User struct {
+		ID        string `json:"_id"`
+		Email     string `json:"email"`
+		FirstName string `json:"firstName"`
+		LastName  string `json:"lastName"`
+		PublicKey string `json:"publicKey"`
+	} `json:"user"`
+}
+
+// ProjectKeysResponse lists the public keys of the project members the
+// project key can be shared with. The server builds it from the
+// memberships rather than from the uploaded project keys, and no endpoint
+// lists those, so whether a member received the key cannot be told.
+type ProjectKeysResponse struct {
+	PublicKeys []struct {
+		PublicKey string `json:"publicKey"`
+		UserId    string `json:"userId"`
+	} `json:"publicKeys"`
+}
+
+// projectMembersWithPublicKeys maps memberships to members, reporting the
+// members without a public key in either the memberships or keys.
+func projectMembersWithPublicKeys(memberships []ProjectMembership, keys ProjectKeysResponse) ([]ProjectMembersModel, []types.String) {
+	listedKeys := map[string]bool{}
+	for _, key := range keys.PublicKeys {
+		if key.PublicKey != "" {
+			listedKeys[key.UserId] = true
+		}
+	}
+
+	members := []ProjectMembersModel{}
+	missingPublicKeyUserIds := []types.String{}
+	for _, membership := range memberships {
+		hasPublicKey := membership.User.PublicKey != "" && listedKeys[membership.User.ID]
+
+		members = append(members, ProjectMembersModel{
+			ID:           types.StringValue(membership.ID),
+			UserId:       types.StringValue(membership.User.ID),
+			Email:        types.StringValue(membership.User.Email),
+			FirstName:    stringOrNull(&membership.User.FirstName),
+			LastName:     stringOrNull(&membership.User.LastName),
+			PublicKey:    stringOrNull(&membership.User.PublicKey),
+			Role:         types.StringValue(membership.Role),
+			HasPublicKey: types.BoolValue(hasPublicKey),
+		})
+		if !hasPublicKey {
+			missingPublicKeyUserIds = append(missingPublicKeyUserIds, types.StringValue(membership.User.ID))
+		}
+	}
+
+	return members, missingPublicKeyUserIds
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProjectMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ProjectMembersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.GetApiV2WorkspaceWorkspaceIdMemberships(ctx, state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Project Members",
+			err.Error(),
+		)
+		return
+	}
+
+	var memberships ProjectMembershipsResponse
+	if err := ic.DecodeResponse(res, &memberships); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Project Members",
+			err.Error(),
+		)
+		return
+	}
+
+	res, err = d.client.GetApiV1WorkspaceWorkspaceIdKeys(ctx, state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Project Keys",
+			err.Error(),
+		)
+		return
+	}
+
+	var keys ProjectKeysResponse
+	if err := ic.DecodeResponse(res, &keys); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Project Keys",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Members, state.MissingPublicKeyUserIds = projectMembersWithPublicKeys(memberships.Memberships, keys)
+	state.ID = state.ProjectId
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,38 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var projectMembersConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+data "infisical_project_members" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+}
+`
+
+func TestAccProjectMembersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + projectMembersConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.infisical_project_members.test", "id", "data.infisical_projects.test", "projects.0.id"),
+					resource.TestCheckResourceAttrSet("data.infisical_project_members.test", "members.0.user_id"),
+					resource.TestCheckResourceAttrSet("data.infisical_project_members.test", "members.0.has_public_key"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,44 @@
+package datasource
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestProjectMembersWithPublicKeysReportsMembersWithoutPublicKey(t *testing.T) {
+	var memberships ProjectMembershipsResponse
+	err := json.Unmarshal([]byte(`{"memberships": [
+		{"_id": "m1", "role": "admin", "user": {"_id": "u1", "email": "owner@example.com", "publicKey": "cHVibGljLWtleQ=="}},
+		{"_id": "m2", "role": "member", "user": {"_id": "u2", "email": "invited@example.com"}}
+	]}`), &memberships)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The server lists every member, whether or not they have a public key.
+	var keys ProjectKeysResponse
+	err = json.Unmarshal([]byte(`{"publicKeys": [
+		{"publicKey": "cHVibGljLWtleQ==", "userId": "u1"},
+		{"publicKey": null, "userId": "u2"}
+	]}`), &keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	members, missing := projectMembersWithPublicKeys(memberships.Memberships, keys)
+	if len(members) != 2 {
+		t.Fatalf("expected 2 members, got %d", len(members))
+	}
+	if !members[0].HasPublicKey.ValueBool() {
+		t.Fatal("expected the member with a public key to be reported as having one")
+	}
+	if members[1].HasPublicKey.ValueBool() {
+		t.Fatal("expected the member without a public key to be reported as missing one")
+	}
+	if !members[1].PublicKey.IsNull() {
+		t.Fatalf("expected a null public key, got %s", members[1].PublicKey)
+	}
+	if len(missing) != 1 || missing[0].ValueString() != "u2" {
+		t.Fatalf("expected only u2 to miss a public key, got %v", missing)
+	}
+}
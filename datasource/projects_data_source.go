@@ -2,15 +2,11 @@ package datasource
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework/path"
-	"io"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	ic "github.com/asheliahut/terraform-provider-infisical/client"
@@ -18,8 +14,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &ProjectsDataSource{}
-	_ datasource.DataSourceWithConfigure = &ProjectsDataSource{}
+	_ datasource.DataSource                   = &ProjectsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &ProjectsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ProjectsDataSource{}
 )
 
 // NewProjectsDataSource is a helper function to simplify the provider implementation.
@@ -29,14 +26,18 @@ func NewProjectsDataSource() datasource.DataSource {
 
 // ProjectsDataSource is the data source implementation.
 type ProjectsDataSource struct {
-	client *ic.Client
+	client *ic.Session
 }
 
 // ProjectsDataSourceModel maps the data source schema data.
 type ProjectsDataSourceModel struct {
-	ID             types.String    `tfsdk:"id"`
-	OrganizationId types.String    `tfsdk:"organization_id"`
-	Projects       []ProjectsModel `tfsdk:"projects"`
+	ID              types.String    `tfsdk:"id"`
+	OrganizationId  types.String    `tfsdk:"organization_id"`
+	Name            types.String    `tfsdk:"name"`
+	NameRegex       types.String    `tfsdk:"name_regex"`
+	EnvironmentSlug types.String    `tfsdk:"environment_slug"`
+	Projects        []ProjectsModel `tfsdk:"projects"`
+	IDs             []types.String  `tfsdk:"ids"`
 }
 
 // ProjectsModel maps projects schema data.
@@ -60,16 +61,33 @@ func (d *ProjectsDataSource) Metadata(_ context.Context, req datasource.Metadata
 // Schema defines the schema for the data source.
 func (d *ProjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches the list of projects.",
+		Description: "Fetches the list of projects, optionally filtered by name or environment.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Current Unix timestamp for id.",
+				Description: "Hash of the arguments and the matching project identifiers.",
 				Computed:    true,
 			},
 			"organization_id": schema.StringAttribute{
 				Description: "Identifier of the organization.",
 				Required:    true,
 			},
+			"name": schema.StringAttribute{
+				Description: "Only return projects with exactly this name.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return projects whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"environment_slug": schema.StringAttribute{
+				Description: "Only return projects that have an environment with this slug.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "Identifiers of the matching projects.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"projects": schema.ListNestedAttribute{
 				Description: "List of projects.",
 				Computed:    true,
@@ -116,12 +134,12 @@ func (d *ProjectsDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*ic.Client)
+	client, ok := req.ProviderData.(*ic.Session)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -130,6 +148,11 @@ func (d *ProjectsDataSource) Configure(_ context.Context, req datasource.Configu
 	d.client = client
 }
 
+// ValidateConfig checks that name_regex compiles.
+func (d *ProjectsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	validateRegexAttribute(ctx, req.Config, path.Root("name_regex"), &resp.Diagnostics)
+}
+
 type WorkspacesResponse struct {
 	Projects []struct {
 		ID           string                `json:"_id"`
@@ -147,45 +170,62 @@ type EnvironmentReturn struct {
 	Slug string `json:"slug"`
 }
 
+// listProjects reads the projects of an organization from the v2 endpoint,
+// falling back to the projects of the user on servers without it.
+func listProjects(ctx context.Context, client *ic.Session, organizationId string) (*WorkspacesResponse, error) {
+	var data WorkspacesResponse
+	if client.Supports(ic.APIv2) {
+		res, err := client.GetApiV2OrganizationsOrganizationIdWorkspaces(ctx, organizationId)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ic.DecodeResponse(res, &data)
+		if !ic.IsNotFound(err) {
+			return &data, err
+		}
+	}
+
+	res, err := client.GetApiV1OrganizationOrganizationIdMyWorkspaces(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state ProjectsDataSourceModel
-	// Get the value of the organization_id attribute
-	var organizationId types.String
-	diags := req.Config.GetAttribute(ctx, path.Root("organization_id"), &organizationId)
-
+	diags := req.Config.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
-
-	res, err := d.client.GetApiV2OrganizationsOrganizationIdWorkspaces(ctx, organizationId.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Infisical Projects for User",
-			err.Error(),
-		)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	defer func(Body io.ReadCloser) {
-		err := Body.Close()
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to close body reader for User",
-				err.Error(),
-			)
-			return
-		}
-	}(res.Body)
 
-	var data WorkspacesResponse
-	if err := json.NewDecoder(res.Body).Decode(&data); err != nil && err != io.EOF {
+	data, err := listProjects(ctx, d.client, state.OrganizationId.ValueString())
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Decode Infisical Projects for User",
+			"Unable to Read Infisical Projects for User",
 			err.Error(),
 		)
 		return
 	}
 
+	filter := newNameFilter(state.Name, state.NameRegex)
+	state.Projects = []ProjectsModel{}
+	state.IDs = []types.String{}
 	for _, proj := range data.Projects {
+		if !filter.matches(proj.Name) {
+			continue
+		}
+
 		var environments []ProjectEnvironmentModel
+		hasEnvironment := state.EnvironmentSlug.IsNull()
 
 		for _, env := range proj.Environments {
 			environments = append(environments, ProjectEnvironmentModel{
@@ -193,16 +233,32 @@ func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 				Name: types.StringValue(env.Name),
 				Slug: types.StringValue(env.Slug),
 			})
+			if env.Slug == state.EnvironmentSlug.ValueString() {
+				hasEnvironment = true
+			}
+		}
+		if !hasEnvironment {
+			continue
 		}
+
 		state.Projects = append(state.Projects, ProjectsModel{
 			ID:           types.StringValue(proj.ID),
 			Name:         types.StringValue(proj.Name),
 			Environments: environments,
 		})
+		state.IDs = append(state.IDs, types.StringValue(proj.ID))
 	}
 
-	state.OrganizationId = organizationId
-	state.ID = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
+	var ids []string
+	for _, id := range state.IDs {
+		ids = append(ids, id.ValueString())
+	}
+	state.ID = types.StringValue(contentID([]string{
+		state.OrganizationId.ValueString(),
+		state.Name.ValueString(),
+		state.NameRegex.ValueString(),
+		state.EnvironmentSlug.ValueString(),
+	}, ids))
 
 	// Set state
 	diags = resp.State.Set(ctx, &state)
@@ -16,7 +16,18 @@ data "infisical_projects" "test" {
 }
 `
 
+var filteredProjectsConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id  = data.infisical_organizations.test.organizations.0.id
+    name_regex       = "^does-not-exist-"
+    environment_slug = "prod"
+}
+`
+
 func TestAccProjectsDataSource(t *testing.T) {
+	var id string
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -32,6 +43,22 @@ func TestAccProjectsDataSource(t *testing.T) {
 					// Verify the first coffee to ensure all attributes are set
 					resource.TestCheckResourceAttr("data.infisical_projects.test", "projects.0.id", ""),
 					resource.TestCheckResourceAttr("data.infisical_projects.test", "projects.0.name", ""),
+					tu.CaptureResourceAttr("data.infisical_projects.test", "id", &id),
+				),
+			},
+			// Read testing, the id must be stable across reads
+			{
+				Config: tu.ProviderConfig + testConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("data.infisical_projects.test", "id", &id),
+				),
+			},
+			// Read testing
+			{
+				Config: tu.ProviderConfig + filteredProjectsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infisical_projects.test", "projects.#", "0"),
+					resource.TestCheckResourceAttr("data.infisical_projects.test", "ids.#", "0"),
 				),
 			},
 		},
@@ -0,0 +1,196 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &SecretSnapshotDataSource{}
+	_ datasource.DataSourceWithConfigure = &SecretSnapshotDataSource{}
+)
+
+// NewSecretSnapshotDataSource is a helper function to simplify the provider implementation.
+func NewSecretSnapshotDataSource() datasource.DataSource {
+	return &SecretSnapshotDataSource{}
+}
+
+// SecretSnapshotDataSource is the data source implementation.
+type SecretSnapshotDataSource struct {
+	client *ic.Session
+}
+
+// SecretSnapshotDataSourceModel maps the data source schema data.
+type SecretSnapshotDataSourceModel struct {
+	ID          types.String          `tfsdk:"id"`
+	ProjectId   types.String          `tfsdk:"project_id"`
+	Version     types.Int64           `tfsdk:"version"`
+	Environment types.String          `tfsdk:"environment"`
+	CreatedAt   types.String          `tfsdk:"created_at"`
+	Secrets     []SnapshotSecretModel `tfsdk:"secrets"`
+}
+
+// SnapshotSecretModel maps secrets schema data.
+type SnapshotSecretModel struct {
+	SecretId    types.String `tfsdk:"secret_id"`
+	Environment types.String `tfsdk:"environment"`
+	Type        types.String `tfsdk:"type"`
+	Version     types.Int64  `tfsdk:"version"`
+	Key         types.String `tfsdk:"key"`
+	Value       types.String `tfsdk:"value"`
+}
+
+// Metadata returns the data source type name.
+func (d *SecretSnapshotDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_snapshot"
+}
+
+// Schema defines the schema for the data source.
+func (d *SecretSnapshotDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches and decrypts the secrets of a project as they were at a snapshot version. " +
+			"Requires the private_key provider attribute.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the snapshot.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+			},
+			"version": schema.Int64Attribute{
+				Description: "Version of the snapshot.",
+				Required:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "Only return the secrets of the environment with this slug.",
+				Optional:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Time the snapshot was taken.",
+				Computed:    true,
+			},
+			"secrets": schema.ListNestedAttribute{
+				Description: "Secrets in the snapshot.",
+				Computed:    true,
+				Sensitive:   true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"secret_id": schema.StringAttribute{
+							Description: "Identifier of the secret.",
+							Computed:    true,
+						},
+						"environment": schema.StringAttribute{
+							Description: "Slug of the environment of the secret.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the secret, either shared or personal.",
+							Computed:    true,
+						},
+						"version": schema.Int64Attribute{
+							Description: "Version of the secret in the snapshot.",
+							Computed:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "Decrypted key of the secret.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "Decrypted value of the secret.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SecretSnapshotDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SecretSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SecretSnapshotDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, err := d.client.FindSnapshot(ctx, state.ProjectId.ValueString(), int(state.Version.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Secret Snapshots",
+			err.Error(),
+		)
+		return
+	}
+	if snapshot == nil {
+		resp.Diagnostics.AddError(
+			"Infisical Secret Snapshot Not Found",
+			fmt.Sprintf("Project %s has no snapshot with version %d.", state.ProjectId.ValueString(), state.Version.ValueInt64()),
+		)
+		return
+	}
+
+	secrets, err := d.client.SnapshotSecrets(ctx, state.ProjectId.ValueString(), snapshot.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Secret Snapshot",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(snapshot.ID)
+	state.CreatedAt = types.StringValue(snapshot.CreatedAt)
+	state.Secrets = []SnapshotSecretModel{}
+	for _, secret := range secrets {
+		if !state.Environment.IsNull() && secret.Environment != state.Environment.ValueString() {
+			continue
+		}
+		state.Secrets = append(state.Secrets, SnapshotSecretModel{
+			SecretId:    types.StringValue(secret.SecretId),
+			Environment: types.StringValue(secret.Environment),
+			Type:        types.StringValue(secret.Type),
+			Version:     types.Int64Value(int64(secret.Version)),
+			Key:         types.StringValue(secret.Key),
+			Value:       types.StringValue(secret.Value),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
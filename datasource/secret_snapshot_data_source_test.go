@@ -0,0 +1,43 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var secretSnapshotConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+data "infisical_secret_snapshots" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+    limit      = 1
+}
+
+data "infisical_secret_snapshot" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+    version    = data.infisical_secret_snapshots.test.snapshots.0.version
+}
+`
+
+func TestAccSecretSnapshotDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + secretSnapshotConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.infisical_secret_snapshot.test", "id", "data.infisical_secret_snapshots.test", "snapshots.0.id"),
+					resource.TestCheckResourceAttrSet("data.infisical_secret_snapshot.test", "secrets.#"),
+				),
+			},
+		},
+	})
+}
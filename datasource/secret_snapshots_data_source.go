@@ -0,0 +1,183 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &SecretSnapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &SecretSnapshotsDataSource{}
+)
+
+// NewSecretSnapshotsDataSource is a helper function to simplify the provider implementation.
+func NewSecretSnapshotsDataSource() datasource.DataSource {
+	return &SecretSnapshotsDataSource{}
+}
+
+// SecretSnapshotsDataSource is the data source implementation.
+type SecretSnapshotsDataSource struct {
+	client *ic.Session
+}
+
+// SecretSnapshotsDataSourceModel maps the data source schema data.
+type SecretSnapshotsDataSourceModel struct {
+	ID         types.String           `tfsdk:"id"`
+	ProjectId  types.String           `tfsdk:"project_id"`
+	Offset     types.Int64            `tfsdk:"offset"`
+	Limit      types.Int64            `tfsdk:"limit"`
+	TotalCount types.Int64            `tfsdk:"total_count"`
+	Snapshots  []SecretSnapshotsModel `tfsdk:"snapshots"`
+}
+
+// SecretSnapshotsModel maps snapshots schema data.
+type SecretSnapshotsModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Version            types.Int64  `tfsdk:"version"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	SecretVersionCount types.Int64  `tfsdk:"secret_version_count"`
+}
+
+// Metadata returns the data source type name.
+func (d *SecretSnapshotsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_snapshots"
+}
+
+// Schema defines the schema for the data source.
+func (d *SecretSnapshotsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a page of the secret snapshots of a project, most recent first.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Hash of the arguments and the returned snapshot identifiers.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+			},
+			"offset": schema.Int64Attribute{
+				Description: "Number of snapshots to skip. Defaults to 0.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of snapshots to return. Defaults to %d.", ic.SnapshotsPageSize),
+				Optional:    true,
+			},
+			"total_count": schema.Int64Attribute{
+				Description: "Total number of snapshots of the project, to page with offset and limit.",
+				Computed:    true,
+			},
+			"snapshots": schema.ListNestedAttribute{
+				Description: "List of snapshots.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the snapshot.",
+							Computed:    true,
+						},
+						"version": schema.Int64Attribute{
+							Description: "Version of the snapshot, used by infisical_secret_snapshot and infisical_snapshot_rollback.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Time the snapshot was taken.",
+							Computed:    true,
+						},
+						"secret_version_count": schema.Int64Attribute{
+							Description: "Number of secret versions in the snapshot.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SecretSnapshotsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SecretSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SecretSnapshotsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offset := 0
+	if !state.Offset.IsNull() {
+		offset = int(state.Offset.ValueInt64())
+	}
+	limit := ic.SnapshotsPageSize
+	if !state.Limit.IsNull() {
+		limit = int(state.Limit.ValueInt64())
+	}
+
+	snapshots, err := d.client.ListSnapshots(ctx, state.ProjectId.ValueString(), offset, limit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Secret Snapshots",
+			err.Error(),
+		)
+		return
+	}
+
+	count, err := d.client.CountSnapshots(ctx, state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Secret Snapshot Count",
+			err.Error(),
+		)
+		return
+	}
+
+	state.TotalCount = types.Int64Value(int64(count))
+	state.Snapshots = []SecretSnapshotsModel{}
+	var ids []string
+	for _, snapshot := range snapshots {
+		ids = append(ids, snapshot.ID)
+		state.Snapshots = append(state.Snapshots, SecretSnapshotsModel{
+			ID:                 types.StringValue(snapshot.ID),
+			Version:            types.Int64Value(int64(snapshot.Version)),
+			CreatedAt:          types.StringValue(snapshot.CreatedAt),
+			SecretVersionCount: types.Int64Value(int64(len(snapshot.SecretVersions))),
+		})
+	}
+
+	state.ID = types.StringValue(contentID([]string{state.ProjectId.ValueString(), state.Offset.String(), state.Limit.String()}, ids))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
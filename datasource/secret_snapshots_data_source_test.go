@@ -0,0 +1,38 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var secretSnapshotsConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+data "infisical_secret_snapshots" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+    limit      = 1
+}
+`
+
+func TestAccSecretSnapshotsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + secretSnapshotsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.infisical_secret_snapshots.test", "total_count"),
+					resource.TestCheckResourceAttr("data.infisical_secret_snapshots.test", "snapshots.#", "1"),
+				),
+			},
+		},
+	})
+}
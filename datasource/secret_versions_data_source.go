@@ -0,0 +1,198 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &SecretVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &SecretVersionsDataSource{}
+)
+
+// NewSecretVersionsDataSource is a helper function to simplify the provider implementation.
+func NewSecretVersionsDataSource() datasource.DataSource {
+	return &SecretVersionsDataSource{}
+}
+
+// SecretVersionsDataSource is the data source implementation.
+type SecretVersionsDataSource struct {
+	client *ic.Session
+}
+
+// SecretVersionsDataSourceModel maps the data source schema data.
+type SecretVersionsDataSourceModel struct {
+	ID        types.String          `tfsdk:"id"`
+	ProjectId types.String          `tfsdk:"project_id"`
+	SecretId  types.String          `tfsdk:"secret_id"`
+	Offset    types.Int64           `tfsdk:"offset"`
+	Limit     types.Int64           `tfsdk:"limit"`
+	Versions  []SecretVersionsModel `tfsdk:"versions"`
+}
+
+// SecretVersionsModel maps versions schema data.
+type SecretVersionsModel struct {
+	ID          types.String `tfsdk:"id"`
+	Version     types.Int64  `tfsdk:"version"`
+	Environment types.String `tfsdk:"environment"`
+	Type        types.String `tfsdk:"type"`
+	IsDeleted   types.Bool   `tfsdk:"is_deleted"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Key         types.String `tfsdk:"key"`
+	Value       types.String `tfsdk:"value"`
+}
+
+// Metadata returns the data source type name.
+func (d *SecretVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_versions"
+}
+
+// Schema defines the schema for the data source.
+func (d *SecretVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches and decrypts the version history of a secret, most recent first. " +
+			"Requires the private_key provider attribute.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the secret.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project of the secret.",
+				Required:    true,
+			},
+			"secret_id": schema.StringAttribute{
+				Description: "Identifier of the secret.",
+				Required:    true,
+			},
+			"offset": schema.Int64Attribute{
+				Description: "Number of versions to skip. Defaults to 0.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of versions to return. Defaults to %d.", ic.SecretVersionsPageSize),
+				Optional:    true,
+			},
+			"versions": schema.ListNestedAttribute{
+				Description: "List of versions.",
+				Computed:    true,
+				Sensitive:   true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the version.",
+							Computed:    true,
+						},
+						"version": schema.Int64Attribute{
+							Description: "Version number.",
+							Computed:    true,
+						},
+						"environment": schema.StringAttribute{
+							Description: "Slug of the environment of the secret.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the secret, either shared or personal.",
+							Computed:    true,
+						},
+						"is_deleted": schema.BoolAttribute{
+							Description: "Whether this version deleted the secret.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Time the version was created.",
+							Computed:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "Decrypted key of the secret at this version.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "Decrypted value of the secret at this version.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SecretVersionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SecretVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SecretVersionsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offset := 0
+	if !state.Offset.IsNull() {
+		offset = int(state.Offset.ValueInt64())
+	}
+	limit := ic.SecretVersionsPageSize
+	if !state.Limit.IsNull() {
+		limit = int(state.Limit.ValueInt64())
+	}
+
+	versions, err := d.client.ListSecretVersions(ctx, state.ProjectId.ValueString(), state.SecretId.ValueString(), offset, limit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Secret Versions",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Versions = []SecretVersionsModel{}
+	for _, version := range versions {
+		state.Versions = append(state.Versions, SecretVersionsModel{
+			ID:          types.StringValue(version.ID),
+			Version:     types.Int64Value(int64(version.Version)),
+			Environment: types.StringValue(version.Environment),
+			Type:        types.StringValue(version.Type),
+			IsDeleted:   types.BoolValue(version.IsDeleted),
+			CreatedAt:   types.StringValue(version.CreatedAt),
+			Key:         types.StringValue(version.Key),
+			Value:       types.StringValue(version.Value),
+		})
+	}
+
+	state.ID = state.SecretId
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,33 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var secretVersionsConfig = `
+data "infisical_secret_versions" "test" {
+    project_id = "63b7a5b3c9f1a2d4e5f60718"
+    secret_id  = "63c1a2b3c4d5e6f708192a3b"
+    limit      = 2
+}
+`
+
+func TestAccSecretVersionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + secretVersionsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infisical_secret_versions.test", "id", "63c1a2b3c4d5e6f708192a3b"),
+					resource.TestCheckResourceAttrSet("data.infisical_secret_versions.test", "versions.0.created_at"),
+				),
+			},
+		},
+	})
+}
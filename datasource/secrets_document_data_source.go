@@ -0,0 +1,272 @@
+package datasource
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &SecretsDocumentDataSource{}
+	_ datasource.DataSourceWithConfigure      = &SecretsDocumentDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &SecretsDocumentDataSource{}
+)
+
+// NewSecretsDocumentDataSource is a helper function to simplify the provider implementation.
+func NewSecretsDocumentDataSource() datasource.DataSource {
+	return &SecretsDocumentDataSource{}
+}
+
+// SecretsDocumentDataSource is the data source implementation.
+type SecretsDocumentDataSource struct {
+	client *ic.Session
+}
+
+// SecretsDocumentDataSourceModel maps the data source schema data.
+type SecretsDocumentDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectId   types.String `tfsdk:"project_id"`
+	Environment types.String `tfsdk:"environment"`
+	Format      types.String `tfsdk:"format"`
+	Content     types.String `tfsdk:"content"`
+}
+
+// documentFormats are the supported values of format.
+var documentFormats = []string{"dotenv", "json", "yaml", "shell"}
+
+// Metadata returns the data source type name.
+func (d *SecretsDocumentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_document"
+}
+
+// Schema defines the schema for the data source.
+func (d *SecretsDocumentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Decrypts the shared secrets of an environment and renders them as a single document, sorted by key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Hash of the arguments and the rendered secret keys.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "Slug of the environment.",
+				Required:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Format of the document, one of " + strings.Join(documentFormats, ", ") + ". Dotenv values are single quoted, except multiline values and values with single quotes, " +
+					"which are double quoted with \\n escapes as read by joho/godotenv, python-dotenv and docker compose. " +
+					"Shell documents consist of export statements.",
+				Required: true,
+			},
+			"content": schema.StringAttribute{
+				Description: "The rendered document.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SecretsDocumentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that format is supported.
+func (d *SecretsDocumentDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var format types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("format"), &format)...)
+	if resp.Diagnostics.HasError() || format.IsNull() || format.IsUnknown() {
+		return
+	}
+
+	for _, supported := range documentFormats {
+		if format.ValueString() == supported {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("format"),
+		"Unsupported Document Format",
+		fmt.Sprintf("Expected one of %s, got: %q.", strings.Join(documentFormats, ", "), format.ValueString()),
+	)
+}
+
+// sharedSecretValues fetches and decrypts the shared secrets of an
+// environment. Personal secrets are left out so the result does not depend
+// on the user the provider is authenticated as.
+func sharedSecretValues(ctx context.Context, client *ic.Session, workspaceId string, environment string) (map[string]string, error) {
+	secrets, err := client.ListSecrets(ctx, workspaceId, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+	for _, secret := range secrets {
+		if secret.Type == "shared" {
+			values[secret.Key] = secret.Value
+		}
+	}
+
+	return values, nil
+}
+
+// shellName matches keys that can be used as variable names in dotenv and
+// shell documents.
+var shellName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// renderSecretsDocument renders secrets in format, with keys in sorted order.
+func renderSecretsDocument(format string, secrets map[string]string) (string, error) {
+	keys := make([]string, 0, len(secrets))
+	for key := range secrets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if format == "dotenv" || format == "shell" {
+		for _, key := range keys {
+			if !shellName.MatchString(key) {
+				return "", fmt.Errorf("secret key %q is not a valid variable name in %s documents", key, format)
+			}
+		}
+	}
+
+	var b strings.Builder
+	switch format {
+	case "dotenv":
+		for _, key := range keys {
+			fmt.Fprintf(&b, "%s=%s\n", key, quoteDotenv(secrets[key]))
+		}
+	case "shell":
+		// Single quoted values are taken literally, including newlines.
+		for _, key := range keys {
+			fmt.Fprintf(&b, "export %s='%s'\n", key, strings.ReplaceAll(secrets[key], "'", `'\''`))
+		}
+	case "json":
+		// Maps are encoded with sorted keys.
+		var buf bytes.Buffer
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(secrets); err != nil {
+			return "", err
+		}
+		b.Write(buf.Bytes())
+	case "yaml":
+		// JSON strings are valid YAML double quoted scalars, which avoids
+		// keys and values being read as numbers, booleans or null.
+		if len(keys) == 0 {
+			b.WriteString("{}\n")
+		}
+		for _, key := range keys {
+			fmt.Fprintf(&b, "%s: %s\n", quoteYAML(key), quoteYAML(secrets[key]))
+		}
+	default:
+		return "", fmt.Errorf("unsupported document format %q", format)
+	}
+
+	return b.String(), nil
+}
+
+// quoteDotenv quotes a dotenv value. Single quoted values are taken literally
+// by the common parsers, so they are used unless the value spans lines or
+// contains a single quote. Those values are double quoted, with newlines,
+// carriage returns, backslashes and double quotes escaped the way
+// joho/godotenv, python-dotenv and docker compose unescape them. Parsers
+// that interpolate variables may expand $ in double quoted values.
+func quoteDotenv(s string) string {
+	if !strings.ContainsAny(s, "'\n\r") {
+		return "'" + s + "'"
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`)
+	return `"` + replacer.Replace(s) + `"`
+}
+
+func quoteYAML(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	// Encoding a string cannot fail.
+	_ = encoder.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SecretsDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SecretsDocumentDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets, err := sharedSecretValues(ctx, d.client, state.ProjectId.ValueString(), state.Environment.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Secrets",
+			err.Error(),
+		)
+		return
+	}
+
+	content, err := renderSecretsDocument(state.Format.ValueString(), secrets)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Render Infisical Secrets",
+			err.Error(),
+		)
+		return
+	}
+
+	var keys []string
+	for key := range secrets {
+		keys = append(keys, key)
+	}
+
+	state.Content = types.StringValue(content)
+	state.ID = types.StringValue(contentID([]string{
+		state.ProjectId.ValueString(),
+		state.Environment.ValueString(),
+		state.Format.ValueString(),
+	}, keys))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,88 @@
+package datasource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/joho/godotenv"
+)
+
+func TestRenderSecretsDocument(t *testing.T) {
+	secrets := map[string]string{
+		"PORT":   "8080",
+		"CERT":   "line one\nline 'two'",
+		"BANNER": `say "hi" to $USER`,
+	}
+
+	for format, expected := range map[string]string{
+		"dotenv": "BANNER='say \"hi\" to $USER'\nCERT=\"line one\\nline 'two'\"\nPORT='8080'\n",
+		"shell":  "export BANNER='say \"hi\" to $USER'\nexport CERT='line one\nline '\\''two'\\'''\nexport PORT='8080'\n",
+		"json":   "{\n  \"BANNER\": \"say \\\"hi\\\" to $USER\",\n  \"CERT\": \"line one\\nline 'two'\",\n  \"PORT\": \"8080\"\n}\n",
+		"yaml":   "\"BANNER\": \"say \\\"hi\\\" to $USER\"\n\"CERT\": \"line one\\nline 'two'\"\n\"PORT\": \"8080\"\n",
+	} {
+		content, err := renderSecretsDocument(format, secrets)
+		if err != nil {
+			t.Fatalf("%s: %s", format, err)
+		}
+		if content != expected {
+			t.Fatalf("%s: expected %q, got %q", format, expected, content)
+		}
+	}
+}
+
+func TestRenderSecretsDocumentEmpty(t *testing.T) {
+	for format, expected := range map[string]string{
+		"dotenv": "",
+		"shell":  "",
+		"json":   "{}\n",
+		"yaml":   "{}\n",
+	} {
+		content, err := renderSecretsDocument(format, map[string]string{})
+		if err != nil {
+			t.Fatalf("%s: %s", format, err)
+		}
+		if content != expected {
+			t.Fatalf("%s: expected %q, got %q", format, expected, content)
+		}
+	}
+}
+
+func TestRenderSecretsDocumentRejectsInvalidNames(t *testing.T) {
+	secrets := map[string]string{"my-key": "value"}
+
+	for _, format := range []string{"dotenv", "shell"} {
+		if _, err := renderSecretsDocument(format, secrets); err == nil {
+			t.Fatalf("%s: expected an error for an invalid variable name", format)
+		}
+	}
+	for _, format := range []string{"json", "yaml"} {
+		if _, err := renderSecretsDocument(format, secrets); err != nil {
+			t.Fatalf("%s: %s", format, err)
+		}
+	}
+}
+
+func TestRenderSecretsDocumentDotenvRoundTrip(t *testing.T) {
+	secrets := map[string]string{
+		"PLAIN":      "8080",
+		"DOLLAR":     "pa$$word${HOME}",
+		"QUOTES":     `say "hi"`,
+		"BACKSLASH":  `C:\tmp\new`,
+		"APOSTROPHE": "it's",
+		"MULTILINE":  "-----BEGIN KEY-----\nabc\\n\"def\"\n-----END KEY-----",
+		"EMPTY":      "",
+	}
+
+	content, err := renderSecretsDocument("dotenv", secrets)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := godotenv.Unmarshal(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(parsed, secrets) {
+		t.Fatalf("expected %q, got %q from\n%s", secrets, parsed, content)
+	}
+}
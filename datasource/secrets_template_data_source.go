@@ -0,0 +1,236 @@
+package datasource
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &SecretsTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure      = &SecretsTemplateDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &SecretsTemplateDataSource{}
+)
+
+// NewSecretsTemplateDataSource is a helper function to simplify the provider implementation.
+func NewSecretsTemplateDataSource() datasource.DataSource {
+	return &SecretsTemplateDataSource{}
+}
+
+// SecretsTemplateDataSource is the data source implementation.
+type SecretsTemplateDataSource struct {
+	client *ic.Session
+}
+
+// SecretsTemplateDataSourceModel maps the data source schema data.
+type SecretsTemplateDataSourceModel struct {
+	ID       types.String                  `tfsdk:"id"`
+	Template types.String                  `tfsdk:"template"`
+	Strict   types.Bool                    `tfsdk:"strict"`
+	Sources  []SecretsTemplateSourcesModel `tfsdk:"sources"`
+	Rendered types.String                  `tfsdk:"rendered"`
+}
+
+// SecretsTemplateSourcesModel maps sources schema data.
+type SecretsTemplateSourcesModel struct {
+	ProjectId   types.String `tfsdk:"project_id"`
+	Environment types.String `tfsdk:"environment"`
+}
+
+// Metadata returns the data source type name.
+func (d *SecretsTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_template"
+}
+
+// Schema defines the schema for the data source.
+func (d *SecretsTemplateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Renders a Go text/template against the decrypted shared secrets of one or more environments. " +
+			"Secrets are available by key, e.g. .DATABASE_URL, and the functions base64, json, default and required can be used in the template.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Hash of the arguments and the available secret keys.",
+				Computed:    true,
+			},
+			"template": schema.StringAttribute{
+				Description: "Go text/template to render.",
+				Required:    true,
+			},
+			"strict": schema.BoolAttribute{
+				Description: "Fail when the template refers to a secret that does not exist, instead of rendering an empty string. Defaults to false. Use index . \"KEY\" for secrets that may be missing in strict templates.",
+				Optional:    true,
+			},
+			"sources": schema.ListNestedAttribute{
+				Description: "Environments to read secrets from. Secrets of later environments override secrets with the same key of earlier ones.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							Description: "Identifier of the project.",
+							Required:    true,
+						},
+						"environment": schema.StringAttribute{
+							Description: "Slug of the environment.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"rendered": schema.StringAttribute{
+				Description: "The rendered template.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SecretsTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that the template parses.
+func (d *SecretsTemplateDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var text types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("template"), &text)...)
+	if resp.Diagnostics.HasError() || text.IsNull() || text.IsUnknown() {
+		return
+	}
+
+	if _, err := newSecretsTemplate(text.ValueString(), false); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("template"),
+			"Invalid Template",
+			err.Error(),
+		)
+	}
+}
+
+// secretsTemplateFuncs are the functions available in templates.
+var secretsTemplateFuncs = template.FuncMap{
+	"base64": func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	},
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	// default and required take the value last so they can be used at the
+	// end of a pipeline, e.g. {{ .PORT | default "8080" }}.
+	"default": func(fallback string, value string) string {
+		if value == "" {
+			return fallback
+		}
+		return value
+	},
+	"required": func(message string, value string) (string, error) {
+		if value == "" {
+			return "", errors.New(message)
+		}
+		return value, nil
+	},
+}
+
+// newSecretsTemplate parses text. Strict templates fail on missing keys.
+func newSecretsTemplate(text string, strict bool) (*template.Template, error) {
+	missingKey := "missingkey=zero"
+	if strict {
+		missingKey = "missingkey=error"
+	}
+
+	return template.New("template").Funcs(secretsTemplateFuncs).Option(missingKey).Parse(text)
+}
+
+// renderSecretsTemplate renders text with secrets as its data.
+func renderSecretsTemplate(text string, strict bool, secrets map[string]string) (string, error) {
+	tmpl, err := newSecretsTemplate(text, strict)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, secrets); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SecretsTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SecretsTemplateDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets := map[string]string{}
+	scope := []string{state.Template.ValueString(), fmt.Sprint(state.Strict.ValueBool())}
+	for _, source := range state.Sources {
+		values, err := sharedSecretValues(ctx, d.client, source.ProjectId.ValueString(), source.Environment.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Infisical Secrets",
+				fmt.Sprintf("Environment %s of project %s: %s", source.Environment.ValueString(), source.ProjectId.ValueString(), err.Error()),
+			)
+			return
+		}
+		for key, value := range values {
+			secrets[key] = value
+		}
+		scope = append(scope, source.ProjectId.ValueString(), source.Environment.ValueString())
+	}
+
+	rendered, err := renderSecretsTemplate(state.Template.ValueString(), state.Strict.ValueBool(), secrets)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Render Infisical Secrets Template",
+			err.Error(),
+		)
+		return
+	}
+
+	var keys []string
+	for key := range secrets {
+		keys = append(keys, key)
+	}
+
+	state.Rendered = types.StringValue(rendered)
+	state.ID = types.StringValue(contentID(scope, keys))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,51 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var secretsTemplateConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+data "infisical_secrets_template" "test" {
+    template = "{{ len . }}"
+    sources = [
+        {
+            project_id  = data.infisical_projects.test.projects.0.id
+            environment = data.infisical_projects.test.projects.0.environments.0.slug
+        },
+    ]
+}
+`
+
+func TestAccSecretsTemplateDataSource(t *testing.T) {
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + secretsTemplateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.infisical_secrets_template.test", "rendered"),
+					tu.CaptureResourceAttr("data.infisical_secrets_template.test", "id", &id),
+				),
+			},
+			// Read testing, the id must be stable across reads
+			{
+				Config: tu.ProviderConfig + secretsTemplateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("data.infisical_secrets_template.test", "id", &id),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,52 @@
+package datasource
+
+import "testing"
+
+func TestRenderSecretsTemplate(t *testing.T) {
+	secrets := map[string]string{
+		"DB_USER":     "app",
+		"DB_PASSWORD": `p"w`,
+		"DB_HOST":     "db.internal",
+	}
+
+	for text, expected := range map[string]string{
+		"postgres://{{ .DB_USER }}@{{ .DB_HOST }}":        "postgres://app@db.internal",
+		"{{ .DB_USER | base64 }}":                         "YXBw",
+		"{{ json .DB_PASSWORD }}":                         `"p\"w"`,
+		`{{ .DB_PORT | default "5432" }}`:                 "5432",
+		`{{ .DB_HOST | default "localhost" }}`:            "db.internal",
+		`{{ .DB_USER | required "DB_USER is required" }}`: "app",
+		"[{{ .DB_PORT }}]":                                "[]",
+	} {
+		rendered, err := renderSecretsTemplate(text, false, secrets)
+		if err != nil {
+			t.Fatalf("%s: %s", text, err)
+		}
+		if rendered != expected {
+			t.Fatalf("%s: expected %q, got %q", text, expected, rendered)
+		}
+	}
+}
+
+func TestRenderSecretsTemplateRequired(t *testing.T) {
+	_, err := renderSecretsTemplate(`{{ .DB_PORT | required "DB_PORT is required" }}`, false, map[string]string{})
+	if err == nil {
+		t.Fatal("expected required to fail for a missing secret")
+	}
+}
+
+func TestRenderSecretsTemplateStrict(t *testing.T) {
+	secrets := map[string]string{"DB_HOST": "db.internal"}
+
+	if _, err := renderSecretsTemplate("{{ .DB_PORT }}", true, secrets); err == nil {
+		t.Fatal("expected strict templates to fail on a missing secret")
+	}
+
+	rendered, err := renderSecretsTemplate(`{{ .DB_HOST }}:{{ index . "DB_PORT" | default "5432" }}`, true, secrets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rendered != "db.internal:5432" {
+		t.Fatalf("unexpected rendering %q", rendered)
+	}
+}
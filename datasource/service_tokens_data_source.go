@@ -0,0 +1,290 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &ServiceTokensDataSource{}
+	_ datasource.DataSourceWithConfigure      = &ServiceTokensDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ServiceTokensDataSource{}
+)
+
+// NewServiceTokensDataSource is a helper function to simplify the provider implementation.
+func NewServiceTokensDataSource() datasource.DataSource {
+	return &ServiceTokensDataSource{}
+}
+
+// ServiceTokensDataSource is the data source implementation.
+type ServiceTokensDataSource struct {
+	client *ic.Session
+}
+
+// ServiceTokensDataSourceModel maps the data source schema data.
+type ServiceTokensDataSourceModel struct {
+	ID                 types.String         `tfsdk:"id"`
+	ProjectId          types.String         `tfsdk:"project_id"`
+	ExpiringWithinDays types.Int64          `tfsdk:"expiring_within_days"`
+	Tokens             []ServiceTokensModel `tfsdk:"tokens"`
+}
+
+// ServiceTokensModel maps tokens schema data.
+type ServiceTokensModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Environment    types.String `tfsdk:"environment"`
+	CreatedBy      types.String `tfsdk:"created_by"`
+	CreatedByEmail types.String `tfsdk:"created_by_email"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	LastUsed       types.String `tfsdk:"last_used"`
+	Expired        types.Bool   `tfsdk:"expired"`
+	ExpiresInDays  types.Int64  `tfsdk:"expires_in_days"`
+}
+
+// Metadata returns the data source type name.
+func (d *ServiceTokensDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_tokens"
+}
+
+// Schema defines the schema for the data source.
+func (d *ServiceTokensDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the service tokens of a project and reports when they expire.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+			},
+			"expiring_within_days": schema.Int64Attribute{
+				Description: "Only return tokens that expire within this number of days, including tokens that have already expired.",
+				Optional:    true,
+			},
+			"tokens": schema.ListNestedAttribute{
+				Description: "List of service tokens.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the service token.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the service token.",
+							Computed:    true,
+						},
+						"environment": schema.StringAttribute{
+							Description: "Slug of the environment the token grants access to.",
+							Computed:    true,
+						},
+						"created_by": schema.StringAttribute{
+							Description: "Identifier of the user who created the token.",
+							Computed:    true,
+						},
+						"created_by_email": schema.StringAttribute{
+							Description: "Email address of the user who created the token, when the API returns it.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Time the token was created.",
+							Computed:    true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "Time the token expires, null for tokens that do not expire.",
+							Computed:    true,
+						},
+						"last_used": schema.StringAttribute{
+							Description: "Time the token was last used.",
+							Computed:    true,
+						},
+						"expired": schema.BoolAttribute{
+							Description: "Whether the token has expired.",
+							Computed:    true,
+						},
+						"expires_in_days": schema.Int64Attribute{
+							Description: "Whole days until the token expires, negative once expired and null for tokens that do not expire.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ServiceTokensDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that expiring_within_days is not negative.
+func (d *ServiceTokensDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var expiringWithinDays types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expiring_within_days"), &expiringWithinDays)...)
+	if resp.Diagnostics.HasError() || expiringWithinDays.IsNull() || expiringWithinDays.IsUnknown() {
+		return
+	}
+
+	if expiringWithinDays.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expiring_within_days"),
+			"Invalid Expiry Window",
+			fmt.Sprintf("Expected a number of days of at least 0, got: %d.", expiringWithinDays.ValueInt64()),
+		)
+	}
+}
+
+type ServiceTokenDataListResponse struct {
+	ServiceTokenData []ServiceTokenData `json:"serviceTokenData"`
+}
+
+type ServiceTokensResponse struct {
+	ServiceTokens []ServiceTokenData `json:"serviceTokens"`
+}
+
+type ServiceTokenData struct {
+	ID          string  `json:"_id"`
+	Name        string  `json:"name"`
+	Environment string  `json:"environment"`
+	CreatedAt   string  `json:"createdAt"`
+	ExpiresAt   *string `json:"expiresAt"`
+	LastUsed    *string `json:"lastUsed"`
+	// User is either the identifier of the creator or the populated user.
+	User json.RawMessage `json:"user"`
+}
+
+// listServiceTokens reads the v2 service token data, falling back to the v1
+// service tokens on servers without it.
+func (d *ServiceTokensDataSource) listServiceTokens(ctx context.Context, workspaceId string) ([]ServiceTokenData, error) {
+	if d.client.Supports(ic.APIv2) {
+		res, err := d.client.GetApiV2WorkspaceWorkspaceIdServiceTokenData(ctx, workspaceId)
+		if err != nil {
+			return nil, err
+		}
+
+		var data ServiceTokenDataListResponse
+		err = ic.DecodeResponse(res, &data)
+		if !ic.IsNotFound(err) {
+			return data.ServiceTokenData, err
+		}
+	}
+
+	res, err := d.client.GetApiV1WorkspaceWorkspaceIdServiceTokens(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens ServiceTokensResponse
+	if err := ic.DecodeResponse(res, &tokens); err != nil {
+		return nil, err
+	}
+
+	return tokens.ServiceTokens, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ServiceTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ServiceTokensDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokens, err := d.listServiceTokens(ctx, state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Service Tokens",
+			err.Error(),
+		)
+		return
+	}
+
+	now := time.Now()
+	state.Tokens = []ServiceTokensModel{}
+	for _, token := range tokens {
+		user, err := decodeUserRef(token.User)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Infisical Service Tokens",
+				err.Error(),
+			)
+			return
+		}
+
+		model := ServiceTokensModel{
+			ID:             types.StringValue(token.ID),
+			Name:           types.StringValue(token.Name),
+			Environment:    types.StringValue(token.Environment),
+			CreatedBy:      stringOrNull(&user.ID),
+			CreatedByEmail: stringOrNull(&user.Email),
+			CreatedAt:      types.StringValue(token.CreatedAt),
+			ExpiresAt:      stringOrNull(token.ExpiresAt),
+			LastUsed:       stringOrNull(token.LastUsed),
+			Expired:        types.BoolValue(false),
+			ExpiresInDays:  types.Int64Null(),
+		}
+
+		if !model.ExpiresAt.IsNull() {
+			expiresAt, err := time.Parse(time.RFC3339, *token.ExpiresAt)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Parse Infisical Service Token Expiry",
+					fmt.Sprintf("Service token %s expires at %q: %s", token.ID, *token.ExpiresAt, err.Error()),
+				)
+				return
+			}
+			remaining := expiresAt.Sub(now)
+			model.Expired = types.BoolValue(remaining <= 0)
+			model.ExpiresInDays = types.Int64Value(int64(math.Floor(remaining.Hours() / 24)))
+		}
+
+		if !state.ExpiringWithinDays.IsNull() {
+			if model.ExpiresInDays.IsNull() || model.ExpiresInDays.ValueInt64() >= state.ExpiringWithinDays.ValueInt64() {
+				continue
+			}
+		}
+
+		state.Tokens = append(state.Tokens, model)
+	}
+
+	state.ID = state.ProjectId
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
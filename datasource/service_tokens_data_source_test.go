@@ -0,0 +1,32 @@
+package datasource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var serviceTokensConfig = `
+data "infisical_service_tokens" "test" {
+    project_id = "63b7a5b3c9f1a2d4e5f60718"
+}
+`
+
+func TestAccServiceTokensDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: tu.ProviderConfig + serviceTokensConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.infisical_service_tokens.test", "id", "63b7a5b3c9f1a2d4e5f60718"),
+					resource.TestCheckResourceAttrSet("data.infisical_service_tokens.test", "tokens.0.created_at"),
+					resource.TestCheckResourceAttrSet("data.infisical_service_tokens.test", "tokens.0.expired"),
+				),
+			},
+		},
+	})
+}
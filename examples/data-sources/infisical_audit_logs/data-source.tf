@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+# Fetch the 200 most recent secret updates of a project.
+data "infisical_audit_logs" "updates" {
+  project_id   = "63b7a5b3c9f1a2d4e5f60718"
+  action_names = ["updateSecrets", "deleteSecrets"]
+  sort_by      = "recent"
+  max_logs     = 200
+}
+
+output "changed_by" {
+  value = distinct([for log in data.infisical_audit_logs.updates.logs : log.user.email])
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+data "infisical_current_user" "me" {}
+
+output "run_by" {
+  value = data.infisical_current_user.me.email
+}
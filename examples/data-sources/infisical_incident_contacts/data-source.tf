@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+data "infisical_organizations" "all" {}
+
+# Read the incident contacts, e.g. to route alerts.
+data "infisical_incident_contacts" "main" {
+  organization_id = data.infisical_organizations.all.organizations[0].id
+}
@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+resource "infisical_integration_auth" "vercel" {
+  project_id  = "63b7a5b3c9f1a2d4e5f60718"
+  integration = "vercel"
+  code        = var.vercel_oauth_code
+}
+
+# Look up the target app by name instead of hard-coding it.
+data "infisical_integration_apps" "web" {
+  integration_auth_id = infisical_integration_auth.vercel.id
+  name                = "web"
+}
+
+resource "infisical_integration" "web" {
+  project_id          = infisical_integration_auth.vercel.project_id
+  integration_auth_id = infisical_integration_auth.vercel.id
+  environment         = "prod"
+  app                 = data.infisical_integration_apps.web.apps[0].name
+  target              = "production"
+}
+
+variable "vercel_oauth_code" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+# List the platforms Infisical can sync secrets to.
+data "infisical_integration_options" "all" {}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+data "infisical_organization" "acme" {
+  name = "Acme"
+}
+
+output "acme_summary" {
+  value = {
+    id                = data.infisical_organization.acme.id
+    projects          = data.infisical_organization.acme.project_count
+    members           = data.infisical_organization.acme.member_count
+    incident_contacts = data.infisical_organization.acme.incident_contact_count
+  }
+}
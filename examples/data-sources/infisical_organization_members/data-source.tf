@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+data "infisical_organizations" "all" {}
+
+# List the admins with a company email address for an access review.
+data "infisical_organization_members" "admins" {
+  organization_id = data.infisical_organizations.all.organizations[0].id
+  role            = "admin"
+  status          = "accepted"
+  email_regex     = "@example\\.com$"
+}
+
+output "admin_emails" {
+  value = data.infisical_organization_members.admins.members[*].email
+}
@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+variable "environments" {
+  type    = list(string)
+  default = ["dev", "staging", "prod", "qa"]
+}
+
+data "infisical_organization_subscription" "current" {
+  organization_id = "63b7a5b3c9f1a2d4e5f60718"
+}
+
+# Fail early when the plan does not allow the requested environments.
+resource "terraform_data" "environments" {
+  input = var.environments
+
+  lifecycle {
+    precondition {
+      condition = (
+        data.infisical_organization_subscription.current.environment_limit == null ||
+        length(var.environments) <= data.infisical_organization_subscription.current.environment_limit
+      )
+      error_message = "The ${data.infisical_organization_subscription.current.tier} plan does not allow ${length(var.environments)} environments."
+    }
+  }
+}
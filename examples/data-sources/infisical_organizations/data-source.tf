@@ -13,4 +13,12 @@ provider "infisical" {
 }
 
 # List all organizations for the user's api_key.
-data "infisical_organizations" "all" {}
\ No newline at end of file
+data "infisical_organizations" "all" {}
+# Only the organizations whose name starts with "acme".
+data "infisical_organizations" "acme" {
+  name_regex = "^acme"
+}
+
+output "acme_organization_ids" {
+  value = data.infisical_organizations.acme.ids
+}
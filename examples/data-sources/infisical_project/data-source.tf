@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+data "infisical_organizations" "all" {}
+
+# Look up a project by name within an organization.
+data "infisical_project" "backend" {
+  organization_id = data.infisical_organizations.all.organizations[0].id
+  name            = "backend"
+}
+
+# Or by id.
+data "infisical_project" "frontend" {
+  id = "63b7a5b3c9f1a2d4e5f60718"
+}
+
+output "backend_environments" {
+  value = data.infisical_project.backend.environments[*].slug
+}
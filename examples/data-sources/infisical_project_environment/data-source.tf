@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+data "infisical_project_environment" "prod" {
+  project_id = "63b7a5b3c9f1a2d4e5f60718"
+  slug       = "prod"
+}
+
+output "prod_secrets" {
+  value = "${data.infisical_project_environment.prod.secret_count} secrets, last modified ${data.infisical_project_environment.prod.last_modified}"
+}
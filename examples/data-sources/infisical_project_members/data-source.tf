@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+data "infisical_project_members" "backend" {
+  project_id = "63b7a5b3c9f1a2d4e5f60718"
+}
+
+# Members without a public key cannot have received the project key.
+output "members_without_public_key" {
+  value = [for member in data.infisical_project_members.backend.members : member.email if !member.has_public_key]
+}
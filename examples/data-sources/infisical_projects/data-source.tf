@@ -17,4 +17,14 @@ data "infisical_organizations" "all" {}
 
 data "infisical_projects" "all" {
   organization_id = data.infisical_organizations.all.organizations[0].id
-}
\ No newline at end of file
+}
+# Only the backend projects that have a production environment.
+data "infisical_projects" "backend" {
+  organization_id  = data.infisical_organizations.all.organizations[0].id
+  name_regex       = "-backend$"
+  environment_slug = "prod"
+}
+
+output "backend_project_ids" {
+  value = data.infisical_projects.backend.ids
+}
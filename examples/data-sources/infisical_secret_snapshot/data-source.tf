@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token   = "YOUR_API_TOKEN"
+  private_key = "YOUR_BASE64_PRIVATE_KEY"
+  host        = "https://infisical.com"
+}
+
+data "infisical_secret_snapshots" "recent" {
+  project_id = "63b7a5b3c9f1a2d4e5f60718"
+  limit      = 2
+}
+
+# Decrypt the production secrets as they were before the latest change.
+data "infisical_secret_snapshot" "previous" {
+  project_id  = "63b7a5b3c9f1a2d4e5f60718"
+  version     = data.infisical_secret_snapshots.recent.snapshots[1].version
+  environment = "prod"
+}
+
+output "previous_keys" {
+  value = [for secret in nonsensitive(data.infisical_secret_snapshot.previous.secrets) : secret.key]
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+# Fetch the ten most recent snapshots of a project.
+data "infisical_secret_snapshots" "recent" {
+  project_id = "63b7a5b3c9f1a2d4e5f60718"
+  limit      = 10
+}
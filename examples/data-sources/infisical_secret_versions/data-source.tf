@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token   = "YOUR_API_TOKEN"
+  private_key = "YOUR_BASE64_PRIVATE_KEY"
+  host        = "https://infisical.com"
+}
+
+# Decrypt the five most recent versions of a secret.
+data "infisical_secret_versions" "database_url" {
+  project_id = "63b7a5b3c9f1a2d4e5f60718"
+  secret_id  = "63c1a2b3c4d5e6f708192a3b"
+  limit      = 5
+}
+
+output "database_url_history" {
+  value = [for version in nonsensitive(data.infisical_secret_versions.database_url.versions) : "${version.version} ${version.created_at}"]
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+data "infisical_secrets_document" "api" {
+  project_id  = "63b7a5b3c9f1a2d4e5f60718"
+  environment = "prod"
+  format      = "dotenv"
+}
+
+resource "local_sensitive_file" "api_env" {
+  content  = data.infisical_secrets_document.api.content
+  filename = "${path.module}/api.env"
+}
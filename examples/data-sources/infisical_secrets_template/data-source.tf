@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+data "infisical_secrets_template" "database_url" {
+  template = "postgres://{{ .DB_USER }}:{{ .DB_PASSWORD }}@{{ .DB_HOST }}:{{ .DB_PORT | default \"5432\" }}/{{ .DB_NAME | required \"DB_NAME is not set\" }}"
+  sources = [
+    {
+      project_id  = "63b7a5b3c9f1a2d4e5f60718"
+      environment = "prod"
+    },
+  ]
+}
+
+data "infisical_secrets_template" "nginx_auth" {
+  template = "proxy_set_header Authorization \"Basic {{ printf \"%s:%s\" .PROXY_USER .PROXY_PASSWORD | base64 }}\";"
+  strict   = true
+  sources = [
+    {
+      project_id  = "63b7a5b3c9f1a2d4e5f60718"
+      environment = "prod"
+    },
+    {
+      project_id  = "63b7a5b3c9f1a2d4e5f60719"
+      environment = "prod"
+    },
+  ]
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+# Service tokens that expire within the next two weeks or have already expired.
+data "infisical_service_tokens" "expiring" {
+  project_id           = "63b7a5b3c9f1a2d4e5f60718"
+  expiring_within_days = 14
+}
+
+output "tokens_to_rotate" {
+  value = {
+    for token in data.infisical_service_tokens.expiring.tokens :
+    token.name => token.expired ? "expired" : "expires in ${token.expires_in_days} days"
+  }
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+# Append new secret reads and updates to a file picked up by the SOC on every apply.
+resource "infisical_audit_log_export" "soc" {
+  project_id   = "63b7a5b3c9f1a2d4e5f60718"
+  path         = "/var/log/infisical/audit.jsonl"
+  format       = "jsonl"
+  action_names = ["readSecrets", "updateSecrets", "deleteSecrets"]
+  since        = "2023-01-01T00:00:00Z"
+}
@@ -0,0 +1,2 @@
+# Incident contacts can be imported using the organization id.
+terraform import infisical_incident_contacts.main 63b7a5b3c9f1a2d4e5f60718
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+data "infisical_organizations" "all" {}
+
+# Any incident contact not listed here is removed on the next apply.
+resource "infisical_incident_contacts" "main" {
+  organization_id = data.infisical_organizations.all.organizations[0].id
+  emails          = ["oncall@example.com", "security@example.com"]
+}
@@ -0,0 +1,2 @@
+# Integrations can be imported using "<project_id>/<integration_id>".
+terraform import infisical_integration.vercel 63b7a5b3c9f1a2d4e5f60718/63c0d1e2f3a4b5c6d7e8f902
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+# Sync the prod environment to the production target of a Vercel app.
+resource "infisical_integration" "vercel" {
+  project_id          = "63b7a5b3c9f1a2d4e5f60718"
+  integration_auth_id = "63c0d1e2f3a4b5c6d7e8f901"
+  environment         = "prod"
+  app                 = "web"
+  target              = "production"
+}
@@ -0,0 +1,2 @@
+# Integration authorizations can be imported using "<project_id>/<integration_auth_id>".
+terraform import infisical_integration_auth.vercel 63b7a5b3c9f1a2d4e5f60718/63c0d1e2f3a4b5c6d7e8f901
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+# Exchange the OAuth code returned by Vercel for an integration authorization.
+resource "infisical_integration_auth" "vercel" {
+  project_id  = "63b7a5b3c9f1a2d4e5f60718"
+  integration = "vercel"
+  code        = var.vercel_oauth_code
+}
+
+variable "vercel_oauth_code" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Organization members can be imported using "<organization_id>/<membership_id>".
+terraform import infisical_organization_member.jane 63b7a5b3c9f1a2d4e5f60718/63c0d1e2f3a4b5c6d7e8f901
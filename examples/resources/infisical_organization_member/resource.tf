@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token = "YOUR_API_TOKEN"
+  host      = "https://infisical.com"
+}
+
+data "infisical_organizations" "all" {}
+
+# Invite an engineer to the first organization as an admin.
+resource "infisical_organization_member" "jane" {
+  organization_id = data.infisical_organizations.all.organizations[0].id
+  email           = "jane@example.com"
+  role            = "admin"
+}
@@ -0,0 +1,2 @@
+# The bot of a project can be imported using the project identifier.
+terraform import infisical_project_bot.bot 63b7a5b3c9f1a2d4e5f60718
@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token   = "YOUR_API_TOKEN"
+  private_key = "YOUR_BASE64_PRIVATE_KEY"
+  host        = "https://infisical.com"
+}
+
+# Activate the bot so that integrations of the project are synced.
+resource "infisical_project_bot" "bot" {
+  project_id = "63b7a5b3c9f1a2d4e5f60718"
+  is_active  = true
+}
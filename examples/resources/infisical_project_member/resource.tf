@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token   = "YOUR_API_TOKEN"
+  private_key = "YOUR_BASE64_PRIVATE_KEY"
+  host        = "https://infisical.com"
+}
+
+data "infisical_organizations" "all" {}
+
+data "infisical_projects" "all" {
+  organization_id = data.infisical_organizations.all.organizations[0].id
+}
+
+# Add an organization member to the first project and share the project key.
+resource "infisical_project_member" "jane" {
+  project_id = data.infisical_projects.all.projects[0].id
+  email      = "jane@example.com"
+  role       = "admin"
+}
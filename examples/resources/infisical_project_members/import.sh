@@ -0,0 +1,2 @@
+# The member list of a project can be imported using the project id.
+terraform import infisical_project_members.backend 63b7a5b3c9f1a2d4e5f60718
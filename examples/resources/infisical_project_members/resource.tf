@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token   = "YOUR_API_TOKEN"
+  private_key = "YOUR_BASE64_PRIVATE_KEY"
+  host        = "https://infisical.com"
+}
+
+data "infisical_organizations" "all" {}
+
+data "infisical_projects" "all" {
+  organization_id = data.infisical_organizations.all.organizations[0].id
+}
+
+# Anyone not listed here is removed from the project on the next apply.
+resource "infisical_project_members" "backend" {
+  project_id = data.infisical_projects.all.projects[0].id
+
+  members = [
+    {
+      email = "platform-bot@example.com"
+      role  = "admin"
+    },
+    {
+      email = "jane@example.com"
+      role  = "member"
+    },
+  ]
+}
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    infisical = {
+      source = "infisical/infisical"
+      version = "0.1"
+    }
+  }
+}
+
+provider "infisical" {
+  api_token   = "YOUR_API_TOKEN"
+  private_key = "YOUR_BASE64_PRIVATE_KEY"
+  host        = "https://infisical.com"
+}
+
+# Roll the project back to snapshot 42. Changing the version rolls back again,
+# and the plan lists every secret key the rollback changes.
+resource "infisical_snapshot_rollback" "prod" {
+  project_id = "63b7a5b3c9f1a2d4e5f60718"
+  version    = 42
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -16,6 +17,7 @@ import (
 
 	ic "github.com/asheliahut/terraform-provider-infisical/client"
 	ds "github.com/asheliahut/terraform-provider-infisical/datasource"
+	rs "github.com/asheliahut/terraform-provider-infisical/resource"
 )
 
 // Ensure the implementation satisfies the expected interfaces
@@ -33,8 +35,9 @@ type InfisicalProvider struct{}
 
 // InfisicalProviderModel maps provider schema data to a Go type.
 type InfisicalProviderModel struct {
-	Host     types.String `tfsdk:"host"`
-	ApiToken types.String `tfsdk:"api_token"`
+	Host       types.String `tfsdk:"host"`
+	ApiToken   types.String `tfsdk:"api_token"`
+	PrivateKey types.String `tfsdk:"private_key"`
 }
 
 // Metadata returns the provider type name.
@@ -52,7 +55,12 @@ func (p *InfisicalProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 			},
 			"api_token": schema.StringAttribute{
-				Description: "Username for infisical API. May also be provided via INFISICAL_API_TOKEN environment variable.",
+				Description: "API key or service token for infisical API. Service tokens start with st. and only grant access to their project. May also be provided via INFISICAL_API_TOKEN environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"private_key": schema.StringAttribute{
+				Description: "Base64 encoded private key of the user, required to share project keys and decrypt secrets. May also be provided via INFISICAL_PRIVATE_KEY environment variable.",
 				Optional:    true,
 				Sensitive:   true,
 			},
@@ -83,6 +91,15 @@ func (p *InfisicalProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	if config.PrivateKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_key"),
+			"Unknown Infisical Private Key",
+			"The provider cannot create the Infisical API client as there is an unknown configuration value for the Infisical Private Key. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the INFISICAL_PRIVATE_KEY environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -96,6 +113,7 @@ func (p *InfisicalProvider) Configure(ctx context.Context, req provider.Configur
 		host = "https://infisical.com"
 	}
 	apiToken := os.Getenv("INFISICAL_API_TOKEN")
+	privateKey := os.Getenv("INFISICAL_PRIVATE_KEY")
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
@@ -105,6 +123,10 @@ func (p *InfisicalProvider) Configure(ctx context.Context, req provider.Configur
 		apiToken = config.ApiToken.ValueString()
 	}
 
+	if !config.PrivateKey.IsNull() {
+		privateKey = config.PrivateKey.ValueString()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 	if apiToken == "" {
@@ -117,6 +139,21 @@ func (p *InfisicalProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	// The private key is optional, only end-to-end encrypted operations need it.
+	var privateKeyBytes []byte
+	if privateKey != "" {
+		key, err := ic.DecodeKey(privateKey)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("private_key"),
+				"Invalid Infisical Private Key",
+				"The provider cannot use the Infisical Private Key as it is not a base64 encoded 32 byte key: "+err.Error(),
+			)
+		} else {
+			privateKeyBytes = key[:]
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -127,10 +164,22 @@ func (p *InfisicalProvider) Configure(ctx context.Context, req provider.Configur
 
 	tflog.Debug(ctx, "Creating Infisical client")
 
-	// Create a new Infisical client using the configuration values
-	apiTokenProvider, apiTokenProviderErr := securityprovider.NewSecurityProviderApiKey("header", "X-API-Key", apiToken)
-	if apiTokenProviderErr != nil {
-		panic(apiTokenProviderErr)
+	// Create a new Infisical client using the configuration values. Service
+	// tokens are sent as bearer tokens, API keys in their own header.
+	serviceToken := strings.HasPrefix(apiToken, "st.")
+	var apiTokenEditor ic.RequestEditorFn
+	if serviceToken {
+		bearerTokenProvider, bearerTokenProviderErr := securityprovider.NewSecurityProviderBearerToken(apiToken)
+		if bearerTokenProviderErr != nil {
+			panic(bearerTokenProviderErr)
+		}
+		apiTokenEditor = bearerTokenProvider.Intercept
+	} else {
+		apiTokenProvider, apiTokenProviderErr := securityprovider.NewSecurityProviderApiKey("header", "X-API-Key", apiToken)
+		if apiTokenProviderErr != nil {
+			panic(apiTokenProviderErr)
+		}
+		apiTokenEditor = apiTokenProvider.Intercept
 	}
 
 	customProvider := func(ctx context.Context, req *http.Request) error {
@@ -139,7 +188,7 @@ func (p *InfisicalProvider) Configure(ctx context.Context, req provider.Configur
 		return nil
 	}
 
-	client, err := ic.NewClient(host, ic.WithRequestEditorFn(apiTokenProvider.Intercept), ic.WithRequestEditorFn(customProvider))
+	client, err := ic.NewClient(host, ic.WithRequestEditorFn(apiTokenEditor), ic.WithRequestEditorFn(customProvider))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Infisical API Client",
@@ -150,10 +199,32 @@ func (p *InfisicalProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	// Make the Infisical client available during DataSource and Resource
+	// Detect the server version and API generations, so that data sources
+	// and resources can pick the endpoints the server supports. When the
+	// server cannot be probed, every generation is assumed to be available.
+	capabilities, err := client.DetectCapabilities(ctx, serviceToken)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to detect Infisical server capabilities", map[string]any{"error": err.Error()})
+		capabilities = nil
+	} else {
+		tflog.Debug(ctx, "Detected Infisical server capabilities", map[string]any{
+			"version":     capabilities.Version,
+			"generations": capabilities.Generations,
+		})
+		client.RequestEditors = append(client.RequestEditors, capabilities.Intercept)
+	}
+
+	session := &ic.Session{
+		Client:       client,
+		PrivateKey:   privateKeyBytes,
+		ServiceToken: serviceToken,
+		Capabilities: capabilities,
+	}
+
+	// Make the Infisical session available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = session
+	resp.ResourceData = session
 
 	tflog.Info(ctx, "Configured Infisical client", map[string]any{"success": true})
 }
@@ -163,10 +234,37 @@ func (p *InfisicalProvider) DataSources(_ context.Context) []func() datasource.D
 	return []func() datasource.DataSource{
 		ds.NewOrganizationsDataSource,
 		ds.NewProjectsDataSource,
+		ds.NewIncidentContactsDataSource,
+		ds.NewIntegrationOptionsDataSource,
+		ds.NewIntegrationAppsDataSource,
+		ds.NewAuditLogsDataSource,
+		ds.NewSecretSnapshotsDataSource,
+		ds.NewSecretSnapshotDataSource,
+		ds.NewSecretVersionsDataSource,
+		ds.NewCurrentUserDataSource,
+		ds.NewOrganizationMembersDataSource,
+		ds.NewProjectMembersDataSource,
+		ds.NewServiceTokensDataSource,
+		ds.NewOrganizationSubscriptionDataSource,
+		ds.NewProjectDataSource,
+		ds.NewOrganizationDataSource,
+		ds.NewProjectEnvironmentDataSource,
+		ds.NewSecretsDocumentDataSource,
+		ds.NewSecretsTemplateDataSource,
 	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *InfisicalProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		rs.NewOrganizationMemberResource,
+		rs.NewProjectMemberResource,
+		rs.NewProjectMembersResource,
+		rs.NewIncidentContactsResource,
+		rs.NewIntegrationResource,
+		rs.NewIntegrationAuthResource,
+		rs.NewProjectBotResource,
+		rs.NewAuditLogExportResource,
+		rs.NewSnapshotRollbackResource,
+	}
 }
@@ -0,0 +1,405 @@
+package resource
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &AuditLogExportResource{}
+	_ resource.ResourceWithConfigure      = &AuditLogExportResource{}
+	_ resource.ResourceWithModifyPlan     = &AuditLogExportResource{}
+	_ resource.ResourceWithValidateConfig = &AuditLogExportResource{}
+)
+
+var auditLogExportFormats = []string{"jsonl", "csv"}
+
+// auditLogCSVHeader lists the columns written by the csv format.
+var auditLogCSVHeader = []string{"id", "created_at", "user_id", "user_email", "channel", "ip_address", "action_names"}
+
+// NewAuditLogExportResource is a helper function to simplify the provider implementation.
+func NewAuditLogExportResource() resource.Resource {
+	return &AuditLogExportResource{}
+}
+
+// AuditLogExportResource is the resource implementation.
+type AuditLogExportResource struct {
+	client *ic.Session
+}
+
+// AuditLogExportResourceModel maps the resource schema data.
+type AuditLogExportResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	ProjectId        types.String   `tfsdk:"project_id"`
+	Path             types.String   `tfsdk:"path"`
+	Format           types.String   `tfsdk:"format"`
+	UserId           types.String   `tfsdk:"user_id"`
+	ActionNames      []types.String `tfsdk:"action_names"`
+	Since            types.String   `tfsdk:"since"`
+	LastLogId        types.String   `tfsdk:"last_log_id"`
+	LastLogCreatedAt types.String   `tfsdk:"last_log_created_at"`
+	ExportedCount    types.Int64    `tfsdk:"exported_count"`
+}
+
+// Metadata returns the resource type name.
+func (r *AuditLogExportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_log_export"
+}
+
+// Schema defines the schema for the resource.
+func (r *AuditLogExportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Appends the audit logs of a project to a local file. The last exported log is kept in state, " +
+			"so every apply after new logs were created exports only those. Destroying the resource keeps the file.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Path of the export file.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the file the logs are appended to. It is created if it does not exist.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"format": schema.StringAttribute{
+				Description: "Format of the file, either jsonl with one log object per line, or csv with a header row.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Only export logs of this project member.",
+				Optional:    true,
+			},
+			"action_names": schema.ListAttribute{
+				Description: "Only export logs containing one of these actions, e.g. readSecrets or updateSecrets.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"since": schema.StringAttribute{
+				Description: "RFC 3339 timestamp before which logs are skipped by the first export. By default all logs are exported.",
+				Optional:    true,
+			},
+			"last_log_id": schema.StringAttribute{
+				Description: "Identifier of the most recent exported log, the checkpoint of the next export.",
+				Computed:    true,
+			},
+			"last_log_created_at": schema.StringAttribute{
+				Description: "Creation time of the most recent exported log. When that log no longer matches the filters, the next export starts after this time.",
+				Computed:    true,
+			},
+			"exported_count": schema.Int64Attribute{
+				Description: "Number of logs written by the last export.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *AuditLogExportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the format and the since timestamp.
+func (r *AuditLogExportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var format, since types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("format"), &format)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("since"), &since)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !format.IsNull() && !format.IsUnknown() && !contains(auditLogExportFormats, format.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("format"),
+			"Invalid Export Format",
+			fmt.Sprintf("Expected one of %s, got: %q.", strings.Join(auditLogExportFormats, ", "), format.ValueString()),
+		)
+	}
+
+	if !since.IsNull() && !since.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, since.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("since"),
+				"Invalid Timestamp",
+				fmt.Sprintf("Expected an RFC 3339 timestamp, got: %q.", since.ValueString()),
+			)
+		}
+	}
+}
+
+// ModifyPlan plans an export whenever a log newer than the checkpoint exists.
+func (r *AuditLogExportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The filters are only known once the values they depend on are.
+	var projectId, userId types.String
+	var actionNames types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectId)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("user_id"), &userId)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("action_names"), &actionNames)...)
+	if resp.Diagnostics.HasError() || projectId.IsUnknown() || userId.IsUnknown() || actionNames.IsUnknown() {
+		return
+	}
+
+	var plan, state AuditLogExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := plan.params()
+	sortBy := ic.Recent
+	params.SortBy = &sortBy
+
+	logs, err := r.client.ListLogs(ctx, plan.ProjectId.ValueString(), params, 1)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Audit Logs",
+			err.Error(),
+		)
+		return
+	}
+
+	if len(logs) == 0 || logs[0].Id == nil || *logs[0].Id == state.LastLogId.ValueString() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_log_id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_log_created_at"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("exported_count"), types.Int64Unknown())...)
+}
+
+// params maps the filters to the query parameters of the logs endpoint.
+func (m *AuditLogExportResourceModel) params() ic.GetApiV1WorkspaceWorkspaceIdLogsParams {
+	var params ic.GetApiV1WorkspaceWorkspaceIdLogsParams
+	if !m.UserId.IsNull() {
+		userId := m.UserId.ValueString()
+		params.UserId = &userId
+	}
+	if len(m.ActionNames) > 0 {
+		var names []string
+		for _, name := range m.ActionNames {
+			names = append(names, name.ValueString())
+		}
+		actionNames := strings.Join(names, ",")
+		params.ActionNames = &actionNames
+	}
+	return params
+}
+
+// export appends the logs created after the checkpoint to the file and
+// advances the checkpoint.
+func (r *AuditLogExportResource) export(ctx context.Context, model *AuditLogExportResourceModel) error {
+	since := model.LastLogCreatedAt
+	if since.IsNull() || since.IsUnknown() {
+		since = model.Since
+	}
+
+	var sinceTime time.Time
+	if !since.IsNull() && !since.IsUnknown() {
+		t, err := time.Parse(time.RFC3339, since.ValueString())
+		if err != nil {
+			return err
+		}
+		sinceTime = t
+	}
+
+	lastLogId := ""
+	if !model.LastLogId.IsNull() && !model.LastLogId.IsUnknown() {
+		lastLogId = model.LastLogId.ValueString()
+	}
+
+	logs, err := r.client.ListLogsAfter(ctx, model.ProjectId.ValueString(), model.params(), lastLogId, sinceTime)
+	if err != nil {
+		return err
+	}
+
+	if err := appendLogs(model.Path.ValueString(), model.Format.ValueString(), logs); err != nil {
+		return err
+	}
+
+	if len(logs) > 0 {
+		last := logs[len(logs)-1]
+		model.LastLogId = stringOrNull(last.Id)
+		model.LastLogCreatedAt = stringOrNull(last.CreatedAt)
+	}
+	if model.LastLogId.IsUnknown() {
+		model.LastLogId = types.StringNull()
+	}
+	if model.LastLogCreatedAt.IsUnknown() {
+		model.LastLogCreatedAt = types.StringNull()
+	}
+	model.ExportedCount = types.Int64Value(int64(len(logs)))
+
+	return nil
+}
+
+// appendLogs writes logs to the end of the file, creating it if needed. The
+// csv header is written only to empty files.
+func appendLogs(filePath string, format string, logs []ic.Log) (err error) {
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	if format == "jsonl" {
+		encoder := json.NewEncoder(file)
+		for _, log := range logs {
+			if err := encoder.Encode(log); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(file)
+	if info.Size() == 0 {
+		if err := writer.Write(auditLogCSVHeader); err != nil {
+			return err
+		}
+	}
+	for _, log := range logs {
+		row := []string{
+			stringOrNull(log.Id).ValueString(),
+			stringOrNull(log.CreatedAt).ValueString(),
+			"",
+			"",
+			stringOrNull(log.Channel).ValueString(),
+			stringOrNull(log.IpAddress).ValueString(),
+			"",
+		}
+		if log.User != nil {
+			row[2] = stringOrNull(log.User.Id).ValueString()
+			row[3] = stringOrNull(log.User.Email).ValueString()
+		}
+		if log.ActionNames != nil {
+			row[6] = strings.Join(*log.ActionNames, ";")
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// Create runs the first export.
+func (r *AuditLogExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AuditLogExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.export(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Export Infisical Audit Logs",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.Path
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the checkpoint, new logs are detected while planning.
+func (r *AuditLogExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AuditLogExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update exports the logs created since the checkpoint in state.
+func (r *AuditLogExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state AuditLogExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastLogId = state.LastLogId
+	plan.LastLogCreatedAt = state.LastLogCreatedAt
+
+	if err := r.export(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Export Infisical Audit Logs",
+			err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the resource from state, the file is kept.
+func (r *AuditLogExportResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
@@ -0,0 +1,43 @@
+package resource_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var auditLogExportConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+resource "infisical_audit_log_export" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+    path       = %q
+    format     = "csv"
+}
+`
+
+func TestAccAuditLogExportResource(t *testing.T) {
+	exportPath := filepath.Join(t.TempDir(), "audit.csv")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: tu.ProviderConfig + fmt.Sprintf(auditLogExportConfig, exportPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("infisical_audit_log_export.test", "id", exportPath),
+					resource.TestCheckResourceAttrSet("infisical_audit_log_export.test", "exported_count"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,290 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &IncidentContactsResource{}
+	_ resource.ResourceWithConfigure   = &IncidentContactsResource{}
+	_ resource.ResourceWithImportState = &IncidentContactsResource{}
+)
+
+// NewIncidentContactsResource is a helper function to simplify the provider implementation.
+func NewIncidentContactsResource() resource.Resource {
+	return &IncidentContactsResource{}
+}
+
+// IncidentContactsResource is the resource implementation.
+type IncidentContactsResource struct {
+	client *ic.Session
+}
+
+// IncidentContactsResourceModel maps the resource schema data.
+type IncidentContactsResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	OrganizationId types.String   `tfsdk:"organization_id"`
+	Emails         []types.String `tfsdk:"emails"`
+}
+
+// Metadata returns the resource type name.
+func (r *IncidentContactsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident_contacts"
+}
+
+// Schema defines the schema for the resource.
+func (r *IncidentContactsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages the incident contacts of an organization. Contacts that are not listed are removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the organization.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"emails": schema.SetAttribute{
+				Description: "Complete set of incident contact email addresses.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IncidentContactsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type IncidentContactsResponse struct {
+	IncidentContacts []struct {
+		ID    string `json:"_id"`
+		Email string `json:"email"`
+	} `json:"incidentContactsOrg"`
+}
+
+func (r *IncidentContactsResource) listContacts(ctx context.Context, organizationId string) ([]string, error) {
+	res, err := r.client.GetApiV1OrganizationOrganizationIdIncidentContactOrg(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var data IncidentContactsResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	emails := []string{}
+	for _, contact := range data.IncidentContacts {
+		emails = append(emails, contact.Email)
+	}
+
+	return emails, nil
+}
+
+func (r *IncidentContactsResource) addContact(ctx context.Context, organizationId string, email string) error {
+	res, err := r.client.PostApiV1OrganizationOrganizationIdIncidentContactOrg(ctx, organizationId, ic.PostApiV1OrganizationOrganizationIdIncidentContactOrgJSONRequestBody{
+		Email: ic.Ptr(email),
+	})
+	if err != nil {
+		return err
+	}
+
+	return ic.DecodeResponse(res, nil)
+}
+
+func (r *IncidentContactsResource) removeContact(ctx context.Context, organizationId string, email string) error {
+	res, err := r.client.DeleteApiV1OrganizationOrganizationIdIncidentContactOrg(ctx, organizationId, ic.DeleteApiV1OrganizationOrganizationIdIncidentContactOrgJSONRequestBody{
+		Email: ic.Ptr(email),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := ic.DecodeResponse(res, nil); err != nil && !ic.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// reconcile adds and removes contacts until the organization matches emails.
+func (r *IncidentContactsResource) reconcile(ctx context.Context, organizationId string, emails []types.String) error {
+	current, err := r.listContacts(ctx, organizationId)
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	for _, email := range current {
+		existing[strings.ToLower(email)] = true
+	}
+
+	wanted := map[string]bool{}
+	for _, email := range emails {
+		wanted[strings.ToLower(email.ValueString())] = true
+		if existing[strings.ToLower(email.ValueString())] {
+			continue
+		}
+		if err := r.addContact(ctx, organizationId, email.ValueString()); err != nil {
+			return fmt.Errorf("unable to add %q: %w", email.ValueString(), err)
+		}
+	}
+
+	for _, email := range current {
+		if wanted[strings.ToLower(email)] {
+			continue
+		}
+		if err := r.removeContact(ctx, organizationId, email); err != nil {
+			return fmt.Errorf("unable to remove %q: %w", email, err)
+		}
+	}
+
+	return nil
+}
+
+// Create reconciles the incident contacts with the configuration.
+func (r *IncidentContactsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IncidentContactsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, plan.OrganizationId.ValueString(), plan.Emails); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Infisical Incident Contacts",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.OrganizationId
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *IncidentContactsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IncidentContactsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.listContacts(ctx, state.OrganizationId.ValueString())
+	if err != nil {
+		if ic.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Incident Contacts",
+			err.Error(),
+		)
+		return
+	}
+
+	// Keep the configured spelling of addresses that only differ in case.
+	configured := map[string]types.String{}
+	for _, email := range state.Emails {
+		configured[strings.ToLower(email.ValueString())] = email
+	}
+
+	emails := []types.String{}
+	for _, email := range current {
+		if spelling, ok := configured[strings.ToLower(email)]; ok {
+			emails = append(emails, spelling)
+			continue
+		}
+		emails = append(emails, types.StringValue(email))
+	}
+
+	state.ID = state.OrganizationId
+	state.Emails = emails
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update reconciles the incident contacts with the configuration.
+func (r *IncidentContactsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan IncidentContactsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, plan.OrganizationId.ValueString(), plan.Emails); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Infisical Incident Contacts",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.OrganizationId
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes every managed incident contact.
+func (r *IncidentContactsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IncidentContactsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, email := range state.Emails {
+		if err := r.removeContact(ctx, state.OrganizationId.ValueString(), email.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Infisical Incident Contact",
+				fmt.Sprintf("Unable to remove %q: %s", email.ValueString(), err.Error()),
+			)
+		}
+	}
+}
+
+// ImportState imports the incident contacts of an organization by organization id.
+func (r *IncidentContactsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("organization_id"), req, resp)
+}
@@ -0,0 +1,40 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var incidentContactsConfig = `
+data "infisical_organizations" "test" {}
+
+resource "infisical_incident_contacts" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+    emails          = ["oncall@example.com", "security@example.com"]
+}
+`
+
+func TestAccIncidentContactsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: tu.ProviderConfig + incidentContactsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("infisical_incident_contacts.test", "emails.#", "2"),
+					resource.TestCheckTypeSetElemAttr("infisical_incident_contacts.test", "emails.*", "oncall@example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "infisical_incident_contacts.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
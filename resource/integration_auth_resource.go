@@ -0,0 +1,278 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &IntegrationAuthResource{}
+	_ resource.ResourceWithConfigure   = &IntegrationAuthResource{}
+	_ resource.ResourceWithImportState = &IntegrationAuthResource{}
+)
+
+// NewIntegrationAuthResource is a helper function to simplify the provider implementation.
+func NewIntegrationAuthResource() resource.Resource {
+	return &IntegrationAuthResource{}
+}
+
+// IntegrationAuthResource is the resource implementation.
+type IntegrationAuthResource struct {
+	client *ic.Session
+}
+
+// IntegrationAuthResourceModel maps the resource schema data.
+type IntegrationAuthResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectId   types.String `tfsdk:"project_id"`
+	Integration types.String `tfsdk:"integration"`
+	Code        types.String `tfsdk:"code"`
+}
+
+// Metadata returns the resource type name.
+func (r *IntegrationAuthResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_auth"
+}
+
+// Schema defines the schema for the resource.
+func (r *IntegrationAuthResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authorizes a project to access a third-party platform by exchanging an OAuth code. " +
+			"Infisical creates an inactive integration alongside, which infisical_integration configures.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the integration authorization.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"integration": schema.StringAttribute{
+				Description: "Slug of the third-party platform, e.g. vercel or netlify.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"code": schema.StringAttribute{
+				Description: "OAuth authorization code returned by the platform, required to create the authorization. " +
+					"Codes are single use and the API never returns them, so the code is only used at creation: changing it " +
+					"afterwards has no effect, and it can be left out of the configuration of imported authorizations.",
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IntegrationAuthResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type IntegrationAuthResponse struct {
+	IntegrationAuth IntegrationAuth `json:"integrationAuth"`
+}
+
+type AuthorizationsResponse struct {
+	Authorizations []IntegrationAuth `json:"authorizations"`
+}
+
+type IntegrationAuth struct {
+	ID          string `json:"_id"`
+	Workspace   string `json:"workspace"`
+	Integration string `json:"integration"`
+}
+
+func (r *IntegrationAuthResource) listAuthorizations(ctx context.Context, workspaceId string) ([]IntegrationAuth, error) {
+	res, err := r.client.GetApiV1WorkspaceWorkspaceIdAuthorizations(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var data AuthorizationsResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	return data.Authorizations, nil
+}
+
+// Create exchanges the OAuth code for an integration authorization.
+func (r *IntegrationAuthResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IntegrationAuthResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Code.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("code"),
+			"Missing OAuth Code",
+			"An OAuth authorization code is required to create an integration authorization.",
+		)
+		return
+	}
+
+	res, err := r.client.PostApiV1IntegrationAuthOauthToken(ctx, ic.PostApiV1IntegrationAuthOauthTokenJSONRequestBody{
+		WorkspaceId: ic.Ptr(plan.ProjectId.ValueString()),
+		Integration: ic.Ptr(plan.Integration.ValueString()),
+		Code:        ic.Ptr(plan.Code.ValueString()),
+	})
+	var data IntegrationAuthResponse
+	if err == nil {
+		err = ic.DecodeResponse(res, &data)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Infisical Integration Authorization",
+			err.Error(),
+		)
+		return
+	}
+
+	// Older servers only acknowledge the exchange, so fall back to the
+	// project's authorizations, of which there is one per platform.
+	id := data.IntegrationAuth.ID
+	if id == "" {
+		authorizations, err := r.listAuthorizations(ctx, plan.ProjectId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Infisical Integration Authorizations",
+				err.Error(),
+			)
+			return
+		}
+		for _, authorization := range authorizations {
+			if authorization.Integration == plan.Integration.ValueString() {
+				id = authorization.ID
+				break
+			}
+		}
+	}
+	if id == "" {
+		resp.Diagnostics.AddError(
+			"Unable to Find Infisical Integration Authorization",
+			fmt.Sprintf("No %s authorization was found in project %s after exchanging the code.",
+				plan.Integration.ValueString(), plan.ProjectId.ValueString()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *IntegrationAuthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IntegrationAuthResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authorizations, err := r.listAuthorizations(ctx, state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Integration Authorizations",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, authorization := range authorizations {
+		if authorization.ID == state.ID.ValueString() {
+			state.Integration = types.StringValue(authorization.Integration)
+
+			diags := resp.State.Set(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	// The authorization was revoked outside of Terraform.
+	resp.State.RemoveResource(ctx)
+}
+
+// Update only records the new code, as an existing authorization cannot be re-exchanged.
+func (r *IntegrationAuthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan IntegrationAuthResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete revokes the integration authorization.
+func (r *IntegrationAuthResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IntegrationAuthResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteApiV1IntegrationAuthIntegrationAuthId(ctx, state.ID.ValueString())
+	if err == nil {
+		err = ic.DecodeResponse(res, nil)
+	}
+	if err != nil && !ic.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Infisical Integration Authorization",
+			err.Error(),
+		)
+	}
+}
+
+// ImportState imports an authorization using the "<project_id>/<integration_auth_id>" format.
+func (r *IntegrationAuthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <project_id>/<integration_auth_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
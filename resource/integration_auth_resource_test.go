@@ -0,0 +1,47 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var integrationAuthConfig = `
+resource "infisical_integration_auth" "test" {
+    project_id  = "63b7a5b3c9f1a2d4e5f60718"
+    integration = "vercel"
+    code        = "oauth-code"
+}
+`
+
+func TestAccIntegrationAuthResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: tu.ProviderConfig + integrationAuthConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("infisical_integration_auth.test", "integration", "vercel"),
+					resource.TestCheckResourceAttrSet("infisical_integration_auth.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "infisical_integration_auth.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["infisical_integration_auth.test"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+				// The API never returns the OAuth code.
+				ImportStateVerifyIgnore: []string{"code"},
+			},
+		},
+	})
+}
@@ -0,0 +1,353 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &IntegrationResource{}
+	_ resource.ResourceWithConfigure   = &IntegrationResource{}
+	_ resource.ResourceWithImportState = &IntegrationResource{}
+)
+
+// NewIntegrationResource is a helper function to simplify the provider implementation.
+func NewIntegrationResource() resource.Resource {
+	return &IntegrationResource{}
+}
+
+// IntegrationResource is the resource implementation.
+type IntegrationResource struct {
+	client *ic.Session
+}
+
+// IntegrationResourceModel maps the resource schema data.
+type IntegrationResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	ProjectId         types.String `tfsdk:"project_id"`
+	IntegrationAuthId types.String `tfsdk:"integration_auth_id"`
+	Integration       types.String `tfsdk:"integration"`
+	Environment       types.String `tfsdk:"environment"`
+	App               types.String `tfsdk:"app"`
+	Target            types.String `tfsdk:"target"`
+	Context           types.String `tfsdk:"context"`
+	SiteId            types.String `tfsdk:"site_id"`
+	IsActive          types.Bool   `tfsdk:"is_active"`
+}
+
+// Metadata returns the resource type name.
+func (r *IntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+// Schema defines the schema for the resource.
+func (r *IntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configures which environment of a project is synced to which app of a third-party platform. " +
+			"Infisical creates the integration together with its integration authorization, this resource takes it over and configures it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the integration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"integration_auth_id": schema.StringAttribute{
+				Description: "Identifier of the integration authorization the integration belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"integration": schema.StringAttribute{
+				Description: "Slug of the third-party platform, e.g. vercel or netlify.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Description: "Slug of the environment whose secrets are synced.",
+				Required:    true,
+			},
+			"app": schema.StringAttribute{
+				Description: "Name of the target app on the third-party platform.",
+				Required:    true,
+			},
+			"target": schema.StringAttribute{
+				Description: "Target environment on the third-party platform, e.g. production or preview for Vercel.",
+				Optional:    true,
+			},
+			"context": schema.StringAttribute{
+				Description: "Deploy context on the third-party platform, e.g. production or deploy-preview for Netlify.",
+				Optional:    true,
+			},
+			"site_id": schema.StringAttribute{
+				Description: "Identifier of the target site, used by Netlify.",
+				Optional:    true,
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether secrets are synced. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type IntegrationsResponse struct {
+	Integrations []Integration `json:"integrations"`
+}
+
+type Integration struct {
+	ID              string  `json:"_id"`
+	Workspace       string  `json:"workspace"`
+	Environment     string  `json:"environment"`
+	IsActive        bool    `json:"isActive"`
+	App             *string `json:"app"`
+	Target          *string `json:"target"`
+	Context         *string `json:"context"`
+	SiteId          *string `json:"siteId"`
+	Integration     string  `json:"integration"`
+	IntegrationAuth string  `json:"integrationAuth"`
+}
+
+func (r *IntegrationResource) listIntegrations(ctx context.Context, workspaceId string) ([]Integration, error) {
+	res, err := r.client.GetApiV1WorkspaceWorkspaceIdIntegrations(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var data IntegrationsResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	return data.Integrations, nil
+}
+
+func (r *IntegrationResource) update(ctx context.Context, plan *IntegrationResourceModel) error {
+	body := ic.PatchApiV1IntegrationIntegrationIdJSONRequestBody{
+		App:         ic.Ptr(plan.App.ValueString()),
+		Environment: ic.Ptr(plan.Environment.ValueString()),
+		IsActive:    ic.Ptr(plan.IsActive.ValueBool()),
+		Target:      ic.Ptr(nil),
+		Context:     ic.Ptr(nil),
+		SiteId:      ic.Ptr(nil),
+	}
+	if !plan.Target.IsNull() {
+		body.Target = ic.Ptr(plan.Target.ValueString())
+	}
+	if !plan.Context.IsNull() {
+		body.Context = ic.Ptr(plan.Context.ValueString())
+	}
+	if !plan.SiteId.IsNull() {
+		body.SiteId = ic.Ptr(plan.SiteId.ValueString())
+	}
+
+	res, err := r.client.PatchApiV1IntegrationIntegrationId(ctx, plan.ID.ValueString(), body)
+	if err != nil {
+		return err
+	}
+
+	return ic.DecodeResponse(res, nil)
+}
+
+// Create takes over the integration created for the integration authorization and configures it.
+func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrations, err := r.listIntegrations(ctx, plan.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Integrations",
+			err.Error(),
+		)
+		return
+	}
+
+	var integration *Integration
+	for i := range integrations {
+		if integrations[i].IntegrationAuth == plan.IntegrationAuthId.ValueString() {
+			integration = &integrations[i]
+			break
+		}
+	}
+	if integration == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Find Infisical Integration",
+			fmt.Sprintf("Project %s has no integration for integration authorization %s. "+
+				"Integrations are created when the integration authorization is created.",
+				plan.ProjectId.ValueString(), plan.IntegrationAuthId.ValueString()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(integration.ID)
+	plan.Integration = types.StringValue(integration.Integration)
+	if plan.IsActive.IsUnknown() || plan.IsActive.IsNull() {
+		plan.IsActive = types.BoolValue(true)
+	}
+
+	if err := r.update(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Configure Infisical Integration",
+			err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrations, err := r.listIntegrations(ctx, state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Integrations",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, integration := range integrations {
+		if integration.ID != state.ID.ValueString() {
+			continue
+		}
+
+		state.IntegrationAuthId = types.StringValue(integration.IntegrationAuth)
+		state.Integration = types.StringValue(integration.Integration)
+		state.Environment = types.StringValue(integration.Environment)
+		state.App = stringOrNull(integration.App)
+		state.Target = stringOrNull(integration.Target)
+		state.Context = stringOrNull(integration.Context)
+		state.SiteId = stringOrNull(integration.SiteId)
+		state.IsActive = types.BoolValue(integration.IsActive)
+
+		diags := resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// The integration was deleted outside of Terraform.
+	resp.State.RemoveResource(ctx)
+}
+
+// Update reconfigures the integration.
+func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan IntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Infisical Integration",
+			err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the integration.
+func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteApiV1IntegrationIntegrationId(ctx, state.ID.ValueString())
+	if err == nil {
+		err = ic.DecodeResponse(res, nil)
+	}
+	if err != nil && !ic.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Infisical Integration",
+			err.Error(),
+		)
+	}
+}
+
+// ImportState imports an integration using the "<project_id>/<integration_id>" format.
+func (r *IntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <project_id>/<integration_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// stringOrNull maps missing or empty API values to null, so that unset
+// optional attributes do not show a diff.
+func stringOrNull(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
@@ -0,0 +1,61 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var integrationConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+resource "infisical_integration_auth" "test" {
+    project_id  = data.infisical_projects.test.projects.0.id
+    integration = "vercel"
+    code        = "oauth-code"
+}
+
+resource "infisical_integration" "test" {
+    project_id          = data.infisical_projects.test.projects.0.id
+    integration_auth_id = infisical_integration_auth.test.id
+    environment         = data.infisical_projects.test.projects.0.environments.0.slug
+    app                 = "web"
+    target              = "production"
+}
+`
+
+func TestAccIntegrationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: tu.ProviderConfig + integrationConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("infisical_integration.test", "environment", "data.infisical_projects.test", "projects.0.environments.0.slug"),
+					resource.TestCheckResourceAttr("infisical_integration.test", "app", "web"),
+					resource.TestCheckResourceAttr("infisical_integration.test", "is_active", "true"),
+					resource.TestCheckResourceAttrSet("infisical_integration.test", "integration"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "infisical_integration.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["infisical_integration.test"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,367 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &OrganizationMemberResource{}
+	_ resource.ResourceWithConfigure      = &OrganizationMemberResource{}
+	_ resource.ResourceWithImportState    = &OrganizationMemberResource{}
+	_ resource.ResourceWithValidateConfig = &OrganizationMemberResource{}
+)
+
+var organizationRoles = []string{"owner", "admin", "member"}
+
+// NewOrganizationMemberResource is a helper function to simplify the provider implementation.
+func NewOrganizationMemberResource() resource.Resource {
+	return &OrganizationMemberResource{}
+}
+
+// OrganizationMemberResource is the resource implementation.
+type OrganizationMemberResource struct {
+	client *ic.Session
+}
+
+// OrganizationMemberResourceModel maps the resource schema data.
+type OrganizationMemberResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	Email          types.String `tfsdk:"email"`
+	Role           types.String `tfsdk:"role"`
+	UserId         types.String `tfsdk:"user_id"`
+	Status         types.String `tfsdk:"status"`
+}
+
+// Metadata returns the resource type name.
+func (r *OrganizationMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *OrganizationMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Invites a user to an organization and manages their role.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the organization membership.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Identifier of the organization.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address the invitation is sent to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role of the member in the organization, one of owner, admin or member. Defaults to member.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Identifier of the user, known once the invitation has been accepted.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the membership, either invited or accepted.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *OrganizationMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that the configured role is one Infisical accepts.
+func (r *OrganizationMemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var role types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role"), &role)...)
+	if resp.Diagnostics.HasError() || role.IsNull() || role.IsUnknown() {
+		return
+	}
+
+	if !contains(organizationRoles, role.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role"),
+			"Invalid Organization Role",
+			fmt.Sprintf("Expected one of %s, got: %q.", strings.Join(organizationRoles, ", "), role.ValueString()),
+		)
+	}
+}
+
+type OrganizationMembershipsResponse struct {
+	Memberships []OrganizationMembership `json:"memberships"`
+}
+
+type OrganizationMembership struct {
+	ID           string `json:"_id"`
+	Organization string `json:"organization"`
+	Role         string `json:"role"`
+	Status       string `json:"status"`
+	InviteEmail  string `json:"inviteEmail"`
+	User         *struct {
+		ID        string `json:"_id"`
+		Email     string `json:"email"`
+		FirstName string `json:"firstName"`
+		LastName  string `json:"lastName"`
+		PublicKey string `json:"publicKey"`
+	} `json:"user"`
+}
+
+// Email returns the address of the member, falling back to the invitation
+// address while the user has not signed up yet.
+func (m OrganizationMembership) Email() string {
+	if m.User != nil && m.User.Email != "" {
+		return m.User.Email
+	}
+	return m.InviteEmail
+}
+
+func (r *OrganizationMemberResource) listMemberships(ctx context.Context, organizationId string) ([]OrganizationMembership, error) {
+	res, err := r.client.GetApiV2OrganizationsOrganizationIdMemberships(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var data OrganizationMembershipsResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	return data.Memberships, nil
+}
+
+func (r *OrganizationMemberResource) updateRole(ctx context.Context, organizationId string, membershipId string, role string) error {
+	res, err := r.client.PatchApiV2OrganizationsOrganizationIdMembershipsMembershipId(ctx, organizationId, membershipId, ic.PatchApiV2OrganizationsOrganizationIdMembershipsMembershipIdJSONRequestBody{
+		Role: &role,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ic.DecodeResponse(res, nil)
+}
+
+// Create sends the invitation and applies the requested role.
+func (r *OrganizationMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OrganizationMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PostApiV1InviteOrgSignup(ctx, ic.PostApiV1InviteOrgSignupJSONRequestBody{
+		InviteeEmail:   ic.Ptr(plan.Email.ValueString()),
+		OrganizationId: ic.Ptr(plan.OrganizationId.ValueString()),
+	})
+	if err == nil {
+		err = ic.DecodeResponse(res, nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Invite Infisical Organization Member",
+			err.Error(),
+		)
+		return
+	}
+
+	// The invitation endpoint does not return the membership, so look it up.
+	memberships, err := r.listMemberships(ctx, plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Organization Memberships",
+			err.Error(),
+		)
+		return
+	}
+
+	var membership *OrganizationMembership
+	for i := range memberships {
+		if strings.EqualFold(memberships[i].Email(), plan.Email.ValueString()) {
+			membership = &memberships[i]
+			break
+		}
+	}
+	if membership == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Find Infisical Organization Membership",
+			fmt.Sprintf("No membership for %q was found after sending the invitation.", plan.Email.ValueString()),
+		)
+		return
+	}
+
+	if !plan.Role.IsNull() && !plan.Role.IsUnknown() && plan.Role.ValueString() != membership.Role {
+		if err := r.updateRole(ctx, plan.OrganizationId.ValueString(), membership.ID, plan.Role.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Infisical Organization Member Role",
+				err.Error(),
+			)
+			return
+		}
+		membership.Role = plan.Role.ValueString()
+	}
+
+	setOrganizationMemberState(&plan, membership)
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *OrganizationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OrganizationMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberships, err := r.listMemberships(ctx, state.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Organization Memberships",
+			err.Error(),
+		)
+		return
+	}
+
+	for i := range memberships {
+		if memberships[i].ID == state.ID.ValueString() {
+			setOrganizationMemberState(&state, &memberships[i])
+
+			diags := resp.State.Set(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	// The member left or was removed outside of Terraform.
+	resp.State.RemoveResource(ctx)
+}
+
+// Update changes the role of the member.
+func (r *OrganizationMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OrganizationMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.UserId = state.UserId
+	plan.Status = state.Status
+
+	if plan.Role.IsNull() || plan.Role.IsUnknown() {
+		plan.Role = state.Role
+	} else if plan.Role.ValueString() != state.Role.ValueString() {
+		if err := r.updateRole(ctx, plan.OrganizationId.ValueString(), plan.ID.ValueString(), plan.Role.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Infisical Organization Member Role",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the member from the organization.
+func (r *OrganizationMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.DeleteApiV2OrganizationsOrganizationIdMembershipsMembershipId(ctx, state.OrganizationId.ValueString(), state.ID.ValueString())
+	if err == nil {
+		err = ic.DecodeResponse(res, nil)
+	}
+	if err != nil && !ic.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Infisical Organization Member",
+			err.Error(),
+		)
+	}
+}
+
+// ImportState imports a membership using the "<organization_id>/<membership_id>" format.
+func (r *OrganizationMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <organization_id>/<membership_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func setOrganizationMemberState(state *OrganizationMemberResourceModel, membership *OrganizationMembership) {
+	state.ID = types.StringValue(membership.ID)
+	state.Role = types.StringValue(membership.Role)
+	state.Status = types.StringValue(membership.Status)
+	if state.Email.IsNull() || state.Email.IsUnknown() {
+		state.Email = types.StringValue(membership.Email())
+	}
+	if membership.User != nil {
+		state.UserId = types.StringValue(membership.User.ID)
+	} else {
+		state.UserId = types.StringNull()
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
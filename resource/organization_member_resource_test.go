@@ -0,0 +1,54 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var organizationMemberConfig = `
+data "infisical_organizations" "test" {}
+
+resource "infisical_organization_member" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+    email           = "member@example.com"
+    role            = "member"
+}
+`
+
+var organizationMemberAdminConfig = `
+data "infisical_organizations" "test" {}
+
+resource "infisical_organization_member" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+    email           = "member@example.com"
+    role            = "admin"
+}
+`
+
+func TestAccOrganizationMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: tu.ProviderConfig + organizationMemberConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("infisical_organization_member.test", "email", "member@example.com"),
+					resource.TestCheckResourceAttr("infisical_organization_member.test", "role", "member"),
+					resource.TestCheckResourceAttr("infisical_organization_member.test", "status", "invited"),
+					resource.TestCheckResourceAttrSet("infisical_organization_member.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: tu.ProviderConfig + organizationMemberAdminConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("infisical_organization_member.test", "role", "admin"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,269 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ProjectBotResource{}
+	_ resource.ResourceWithConfigure   = &ProjectBotResource{}
+	_ resource.ResourceWithImportState = &ProjectBotResource{}
+)
+
+// NewProjectBotResource is a helper function to simplify the provider implementation.
+func NewProjectBotResource() resource.Resource {
+	return &ProjectBotResource{}
+}
+
+// ProjectBotResource is the resource implementation.
+type ProjectBotResource struct {
+	client *ic.Session
+}
+
+// ProjectBotResourceModel maps the resource schema data.
+type ProjectBotResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectId types.String `tfsdk:"project_id"`
+	IsActive  types.Bool   `tfsdk:"is_active"`
+	PublicKey types.String `tfsdk:"public_key"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProjectBotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_bot"
+}
+
+// Schema defines the schema for the resource.
+func (r *ProjectBotResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Activates or deactivates the bot of a project, which syncs secrets to integrations on the server. " +
+			"Activating the bot shares the project key with it, which requires the private_key provider attribute.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the bot.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the bot is active.",
+				Required:    true,
+			},
+			"public_key": schema.StringAttribute{
+				Description: "Public key of the bot the project key is encrypted to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProjectBotResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type BotResponse struct {
+	Bot Bot `json:"bot"`
+}
+
+type Bot struct {
+	ID        string `json:"_id"`
+	Workspace string `json:"workspace"`
+	IsActive  bool   `json:"isActive"`
+	PublicKey string `json:"publicKey"`
+}
+
+// getBot fetches the bot of a project, which the server creates on first access.
+func (r *ProjectBotResource) getBot(ctx context.Context, workspaceId string) (*Bot, error) {
+	res, err := r.client.GetApiV1BotWorkspaceId(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var data BotResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	return &data.Bot, nil
+}
+
+// setActive activates the bot with a freshly wrapped project key, or
+// deactivates it, in which case the server discards its copy of the key.
+func (r *ProjectBotResource) setActive(ctx context.Context, workspaceId string, bot *Bot, isActive bool) error {
+	body := ic.PatchApiV1BotBotIdActiveJSONRequestBody{
+		IsActive: ic.Ptr(isActive),
+	}
+	if isActive {
+		encryptedKey, nonce, err := r.client.WrapProjectKey(ctx, workspaceId, bot.PublicKey)
+		if err != nil {
+			return err
+		}
+		body.BotKey = ic.Ptr(map[string]string{
+			"encryptedKey": encryptedKey,
+			"nonce":        nonce,
+		})
+	}
+
+	res, err := r.client.PatchApiV1BotBotIdActive(ctx, bot.ID, body)
+	if err != nil {
+		return err
+	}
+
+	return ic.DecodeResponse(res, nil)
+}
+
+// Create takes over the bot of the project and sets its state.
+func (r *ProjectBotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectBotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bot, err := r.getBot(ctx, plan.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Project Bot",
+			err.Error(),
+		)
+		return
+	}
+
+	if bot.IsActive != plan.IsActive.ValueBool() {
+		if err := r.setActive(ctx, plan.ProjectId.ValueString(), bot, plan.IsActive.ValueBool()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Infisical Project Bot",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(bot.ID)
+	plan.PublicKey = types.StringValue(bot.PublicKey)
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProjectBotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectBotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bot, err := r.getBot(ctx, state.ProjectId.ValueString())
+	if err != nil {
+		if ic.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Project Bot",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(bot.ID)
+	state.IsActive = types.BoolValue(bot.IsActive)
+	state.PublicKey = types.StringValue(bot.PublicKey)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update activates or deactivates the bot.
+func (r *ProjectBotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectBotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bot, err := r.getBot(ctx, plan.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Project Bot",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := r.setActive(ctx, plan.ProjectId.ValueString(), bot, plan.IsActive.ValueBool()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Infisical Project Bot",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(bot.ID)
+	plan.PublicKey = types.StringValue(bot.PublicKey)
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deactivates the bot. The bot itself lives as long as the project.
+func (r *ProjectBotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectBotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bot := &Bot{ID: state.ID.ValueString()}
+	err := r.setActive(ctx, state.ProjectId.ValueString(), bot, false)
+	if err != nil && !ic.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Deactivate Infisical Project Bot",
+			err.Error(),
+		)
+	}
+}
+
+// ImportState imports the bot of a project using the project identifier.
+func (r *ProjectBotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
+}
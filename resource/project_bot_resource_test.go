@@ -0,0 +1,47 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var projectBotConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+resource "infisical_project_bot" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+    is_active  = %t
+}
+`
+
+func TestAccProjectBotResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: tu.ProviderConfig + fmt.Sprintf(projectBotConfig, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("infisical_project_bot.test", "is_active", "true"),
+					resource.TestCheckResourceAttrSet("infisical_project_bot.test", "id"),
+					resource.TestCheckResourceAttrSet("infisical_project_bot.test", "public_key"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: tu.ProviderConfig + fmt.Sprintf(projectBotConfig, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("infisical_project_bot.test", "is_active", "false"),
+				),
+			},
+		},
+	})
+}
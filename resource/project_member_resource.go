@@ -0,0 +1,381 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ProjectMemberResource{}
+	_ resource.ResourceWithConfigure      = &ProjectMemberResource{}
+	_ resource.ResourceWithImportState    = &ProjectMemberResource{}
+	_ resource.ResourceWithValidateConfig = &ProjectMemberResource{}
+)
+
+var projectRoles = []string{"admin", "member"}
+
+// NewProjectMemberResource is a helper function to simplify the provider implementation.
+func NewProjectMemberResource() resource.Resource {
+	return &ProjectMemberResource{}
+}
+
+// ProjectMemberResource is the resource implementation.
+type ProjectMemberResource struct {
+	client *ic.Session
+}
+
+// ProjectMemberResourceModel maps the resource schema data.
+type ProjectMemberResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectId types.String `tfsdk:"project_id"`
+	Email     types.String `tfsdk:"email"`
+	Role      types.String `tfsdk:"role"`
+	UserId    types.String `tfsdk:"user_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProjectMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *ProjectMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Adds an organization member to a project and shares the project key with them. " +
+			"Requires the private_key provider attribute.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the project membership.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address of the user, who must already have accepted their organization invitation.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role of the member in the project, either admin or member. Defaults to member.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Identifier of the user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProjectMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that the configured role is one Infisical accepts.
+func (r *ProjectMemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var role types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role"), &role)...)
+	if resp.Diagnostics.HasError() || role.IsNull() || role.IsUnknown() {
+		return
+	}
+
+	if !contains(projectRoles, role.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role"),
+			"Invalid Project Role",
+			fmt.Sprintf("Expected one of %s, got: %q.", strings.Join(projectRoles, ", "), role.ValueString()),
+		)
+	}
+}
+
+type ProjectMembershipsResponse struct {
+	Memberships []ProjectMembership `json:"memberships"`
+}
+
+type ProjectMembership struct {
+	ID        string `json:"_id"`
+	Role      string `json:"role"`
+	Workspace string `json:"workspace"`
+	User      struct {
+		ID        string `json:"_id"`
+		Email     string `json:"email"`
+		FirstName string `json:"firstName"`
+		LastName  string `json:"lastName"`
+		PublicKey string `json:"publicKey"`
+	} `json:"user"`
+}
+
+func listProjectMemberships(ctx context.Context, client *ic.Session, workspaceId string) ([]ProjectMembership, error) {
+	res, err := client.GetApiV2WorkspaceWorkspaceIdMemberships(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var data ProjectMembershipsResponse
+	if err := ic.DecodeResponse(res, &data); err != nil {
+		return nil, err
+	}
+
+	return data.Memberships, nil
+}
+
+// addProjectMember invites email to the project, shares the project key with
+// the new member and applies role when it differs from the default. When a
+// step after the invitation fails, the membership is removed again so that
+// the next apply can retry instead of finding the email already invited.
+func addProjectMember(ctx context.Context, client *ic.Session, workspaceId string, email string, role string) (*ProjectMembership, error) {
+	if client.PrivateKey == nil {
+		return nil, ic.ErrMissingPrivateKey
+	}
+
+	res, err := client.PostApiV1WorkspaceWorkspaceIdInviteSignup(ctx, workspaceId, ic.PostApiV1WorkspaceWorkspaceIdInviteSignupJSONRequestBody{
+		Email: ic.Ptr(email),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := ic.DecodeResponse(res, nil); err != nil {
+		return nil, err
+	}
+
+	membership, err := findProjectMembership(ctx, client, workspaceId, email)
+	if err != nil {
+		// Without the membership there is nothing to remove, so the
+		// invitation has to be revoked by hand.
+		return nil, fmt.Errorf("unable to find the project membership of %q after sending the invitation, remove the member before retrying: %w", email, err)
+	}
+
+	if err := setUpProjectMember(ctx, client, workspaceId, membership, role); err != nil {
+		if removeErr := removeProjectMember(ctx, client, workspaceId, membership.ID); removeErr != nil {
+			return nil, fmt.Errorf("%w, and removing the membership again failed: %s", err, removeErr.Error())
+		}
+		return nil, err
+	}
+
+	return membership, nil
+}
+
+// findProjectMembership looks up the membership of email in a project.
+func findProjectMembership(ctx context.Context, client *ic.Session, workspaceId string, email string) (*ProjectMembership, error) {
+	memberships, err := listProjectMemberships(ctx, client, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range memberships {
+		if strings.EqualFold(memberships[i].User.Email, email) {
+			return &memberships[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no project membership for %q", email)
+}
+
+// setUpProjectMember shares the project key with a new member and applies role.
+func setUpProjectMember(ctx context.Context, client *ic.Session, workspaceId string, membership *ProjectMembership, role string) error {
+	// Without a copy of the project key the member cannot decrypt anything.
+	if err := client.ShareProjectKey(ctx, workspaceId, membership.User.ID, membership.User.PublicKey); err != nil {
+		return fmt.Errorf("unable to share the project key with %q: %w", membership.User.Email, err)
+	}
+
+	if role != "" && role != membership.Role {
+		if err := updateProjectMemberRole(ctx, client, workspaceId, membership.ID, role); err != nil {
+			return err
+		}
+		membership.Role = role
+	}
+
+	return nil
+}
+
+func updateProjectMemberRole(ctx context.Context, client *ic.Session, workspaceId string, membershipId string, role string) error {
+	res, err := client.PatchApiV2WorkspaceWorkspaceIdMembershipsMembershipId(ctx, workspaceId, membershipId, ic.PatchApiV2WorkspaceWorkspaceIdMembershipsMembershipIdJSONRequestBody{
+		Role: &role,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ic.DecodeResponse(res, nil)
+}
+
+func removeProjectMember(ctx context.Context, client *ic.Session, workspaceId string, membershipId string) error {
+	res, err := client.DeleteApiV2WorkspaceWorkspaceIdMembershipsMembershipId(ctx, workspaceId, membershipId)
+	if err != nil {
+		return err
+	}
+
+	if err := ic.DecodeResponse(res, nil); err != nil && !ic.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// Create adds the member to the project and shares the project key.
+func (r *ProjectMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var role string
+	if !plan.Role.IsNull() && !plan.Role.IsUnknown() {
+		role = plan.Role.ValueString()
+	}
+
+	membership, err := addProjectMember(ctx, r.client, plan.ProjectId.ValueString(), plan.Email.ValueString(), role)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Add Infisical Project Member",
+			err.Error(),
+		)
+		return
+	}
+
+	setProjectMemberState(&plan, membership)
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProjectMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberships, err := listProjectMemberships(ctx, r.client, state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Project Memberships",
+			err.Error(),
+		)
+		return
+	}
+
+	for i := range memberships {
+		if memberships[i].ID == state.ID.ValueString() {
+			setProjectMemberState(&state, &memberships[i])
+
+			diags := resp.State.Set(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	// The member was removed outside of Terraform.
+	resp.State.RemoveResource(ctx)
+}
+
+// Update changes the role of the member.
+func (r *ProjectMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ProjectMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.UserId = state.UserId
+
+	if plan.Role.IsNull() || plan.Role.IsUnknown() {
+		plan.Role = state.Role
+	} else if plan.Role.ValueString() != state.Role.ValueString() {
+		if err := updateProjectMemberRole(ctx, r.client, plan.ProjectId.ValueString(), plan.ID.ValueString(), plan.Role.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Infisical Project Member Role",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the member from the project.
+func (r *ProjectMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := removeProjectMember(ctx, r.client, state.ProjectId.ValueString(), state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Infisical Project Member",
+			err.Error(),
+		)
+	}
+}
+
+// ImportState imports a membership using the "<project_id>/<membership_id>" format.
+func (r *ProjectMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <project_id>/<membership_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func setProjectMemberState(state *ProjectMemberResourceModel, membership *ProjectMembership) {
+	state.ID = types.StringValue(membership.ID)
+	state.Role = types.StringValue(membership.Role)
+	state.UserId = types.StringValue(membership.User.ID)
+	if state.Email.IsNull() || state.Email.IsUnknown() {
+		state.Email = types.StringValue(membership.User.Email)
+	}
+}
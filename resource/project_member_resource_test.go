@@ -0,0 +1,41 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var projectMemberConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+resource "infisical_project_member" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+    email      = "member@example.com"
+    role       = "member"
+}
+`
+
+func TestAccProjectMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: tu.ProviderConfig + projectMemberConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("infisical_project_member.test", "email", "member@example.com"),
+					resource.TestCheckResourceAttr("infisical_project_member.test", "role", "member"),
+					resource.TestCheckResourceAttrSet("infisical_project_member.test", "id"),
+					resource.TestCheckResourceAttrSet("infisical_project_member.test", "user_id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,65 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// newProjectServer serves a project whose memberships are kept in memory.
+// Inviting adds a membership, and fetching the project key always fails.
+func newProjectServer(t *testing.T) (*ic.Session, *[]ProjectMembership) {
+	memberships := []ProjectMembership{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/workspace/project/invite-signup":
+			var body struct {
+				Email string `json:"email"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			membership := ProjectMembership{ID: "membership", Role: "member"}
+			membership.User.ID = "user"
+			membership.User.Email = body.Email
+			membership.User.PublicKey = "cHVibGljLWtleQ=="
+			memberships = append(memberships, membership)
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/workspace/project/memberships":
+			_ = json.NewEncoder(w).Encode(ProjectMembershipsResponse{Memberships: memberships})
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v2/workspace/project/memberships/"):
+			id := strings.TrimPrefix(r.URL.Path, "/api/v2/workspace/project/memberships/")
+			for i := range memberships {
+				if memberships[i].ID == id {
+					memberships = append(memberships[:i], memberships[i+1:]...)
+					break
+				}
+			}
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			http.Error(w, "unavailable", http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := ic.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &ic.Session{Client: client, PrivateKey: []byte("private-key")}, &memberships
+}
+
+func TestAddProjectMemberRemovesMembershipOnFailure(t *testing.T) {
+	client, memberships := newProjectServer(t)
+
+	_, err := addProjectMember(context.Background(), client, "project", "member@example.com", "admin")
+	if err == nil || !strings.Contains(err.Error(), "unable to share the project key") {
+		t.Fatalf("expected the key share to fail, got %v", err)
+	}
+	if len(*memberships) != 0 {
+		t.Fatalf("expected the membership to be removed, got %+v", *memberships)
+	}
+}
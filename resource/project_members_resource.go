@@ -0,0 +1,415 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ProjectMembersResource{}
+	_ resource.ResourceWithConfigure      = &ProjectMembersResource{}
+	_ resource.ResourceWithImportState    = &ProjectMembersResource{}
+	_ resource.ResourceWithModifyPlan     = &ProjectMembersResource{}
+	_ resource.ResourceWithValidateConfig = &ProjectMembersResource{}
+)
+
+// NewProjectMembersResource is a helper function to simplify the provider implementation.
+func NewProjectMembersResource() resource.Resource {
+	return &ProjectMembersResource{}
+}
+
+// ProjectMembersResource is the resource implementation.
+type ProjectMembersResource struct {
+	client *ic.Session
+}
+
+// ProjectMembersResourceModel maps the resource schema data.
+type ProjectMembersResourceModel struct {
+	ID        types.String          `tfsdk:"id"`
+	ProjectId types.String          `tfsdk:"project_id"`
+	Members   []ProjectMembersModel `tfsdk:"members"`
+}
+
+// ProjectMembersModel maps members schema data.
+type ProjectMembersModel struct {
+	Email types.String `tfsdk:"email"`
+	Role  types.String `tfsdk:"role"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProjectMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_members"
+}
+
+// Schema defines the schema for the resource.
+func (r *ProjectMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages the complete member list of a project. Members that are not listed are removed, " +
+			"new members receive a copy of the project key. Requires the private_key provider attribute, and the list must " +
+			"include the user the provider authenticates as.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				Description: "Complete set of project members.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Description: "Email address of the member.",
+							Required:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the member in the project, either admin or member.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProjectMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks roles and rejects duplicate emails.
+func (r *ProjectMembersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectMembersResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, member := range config.Members {
+		if !member.Role.IsNull() && !member.Role.IsUnknown() && !contains(projectRoles, member.Role.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("members"),
+				"Invalid Project Role",
+				fmt.Sprintf("Expected one of %s, got: %q.", strings.Join(projectRoles, ", "), member.Role.ValueString()),
+			)
+		}
+
+		if member.Email.IsNull() || member.Email.IsUnknown() {
+			continue
+		}
+		email := strings.ToLower(member.Email.ValueString())
+		if seen[email] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("members"),
+				"Duplicate Project Member",
+				fmt.Sprintf("The email %q is listed more than once.", member.Email.ValueString()),
+			)
+		}
+		seen[email] = true
+	}
+}
+
+// ModifyPlan rejects member lists without the user the provider
+// authenticates as, and warns about members that exist in the project but
+// not in the configuration, since applying the plan removes them. On
+// creation these are read from the project, as the first apply replaces
+// the members that were added outside of Terraform.
+func (r *ProjectMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan ProjectMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectId.IsUnknown() {
+		return
+	}
+	for _, member := range plan.Members {
+		if member.Email.IsUnknown() {
+			// The final list is not known yet, so nothing can be reported.
+			return
+		}
+	}
+
+	if !r.client.ServiceToken {
+		email, err := r.client.CurrentUserEmail(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Infisical User",
+				err.Error(),
+			)
+			return
+		}
+		if !listsMember(plan.Members, email) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("members"),
+				"Authenticated User Not Listed",
+				fmt.Sprintf("The members of project %s must include %s, the user the provider authenticates as. "+
+					"Removing that user would lock the provider out of the project.", plan.ProjectId.ValueString(), email),
+			)
+			return
+		}
+	}
+
+	var current []string
+	if req.State.Raw.IsNull() {
+		memberships, err := listProjectMemberships(ctx, r.client, plan.ProjectId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Infisical Project Memberships",
+				err.Error(),
+			)
+			return
+		}
+		for _, membership := range memberships {
+			current = append(current, membership.User.Email)
+		}
+	} else {
+		var state ProjectMembersResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, member := range state.Members {
+			current = append(current, member.Email.ValueString())
+		}
+	}
+
+	if removed := removedMembers(current, plan.Members); len(removed) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Project Members Will Be Removed",
+			fmt.Sprintf("The following members of project %s are not in the configuration and will be removed: %s.",
+				plan.ProjectId.ValueString(), strings.Join(removed, ", ")),
+		)
+	}
+}
+
+// listsMember reports whether email is in members, ignoring case.
+func listsMember(members []ProjectMembersModel, email string) bool {
+	for _, member := range members {
+		if strings.EqualFold(member.Email.ValueString(), email) {
+			return true
+		}
+	}
+	return false
+}
+
+// removedMembers returns the sorted emails of current that are not in desired.
+func removedMembers(current []string, desired []ProjectMembersModel) []string {
+	var removed []string
+	for _, email := range current {
+		if !listsMember(desired, email) {
+			removed = append(removed, email)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// reconcile adds, updates and removes memberships until the project matches
+// the desired member list. Removals run last so that a failed key share does
+// not leave the project with fewer members than before, and never include
+// the user the provider authenticates as.
+func (r *ProjectMembersResource) reconcile(ctx context.Context, projectId string, desired []ProjectMembersModel) error {
+	memberships, err := listProjectMemberships(ctx, r.client, projectId)
+	if err != nil {
+		return err
+	}
+
+	current := map[string]ProjectMembership{}
+	for _, membership := range memberships {
+		current[strings.ToLower(membership.User.Email)] = membership
+	}
+
+	wanted := map[string]bool{}
+	for _, member := range desired {
+		email := strings.ToLower(member.Email.ValueString())
+		wanted[email] = true
+
+		membership, ok := current[email]
+		if !ok {
+			if _, err := addProjectMember(ctx, r.client, projectId, member.Email.ValueString(), member.Role.ValueString()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if membership.Role != member.Role.ValueString() {
+			if err := updateProjectMemberRole(ctx, r.client, projectId, membership.ID, member.Role.ValueString()); err != nil {
+				return fmt.Errorf("unable to update the role of %q: %w", member.Email.ValueString(), err)
+			}
+		}
+	}
+
+	var self string
+	if !r.client.ServiceToken {
+		self, err = r.client.CurrentUserEmail(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	for email, membership := range current {
+		if wanted[email] {
+			continue
+		}
+		if self != "" && strings.EqualFold(membership.User.Email, self) {
+			return fmt.Errorf("refusing to remove %q, the user the provider authenticates as", membership.User.Email)
+		}
+		if err := removeProjectMember(ctx, r.client, projectId, membership.ID); err != nil {
+			return fmt.Errorf("unable to remove %q: %w", membership.User.Email, err)
+		}
+	}
+
+	return nil
+}
+
+// refresh replaces the member list in state with the memberships found in
+// the project, keeping the configured spelling of emails that only differ in case.
+func (r *ProjectMembersResource) refresh(ctx context.Context, state *ProjectMembersResourceModel) error {
+	memberships, err := listProjectMemberships(ctx, r.client, state.ProjectId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	configured := map[string]string{}
+	for _, member := range state.Members {
+		configured[strings.ToLower(member.Email.ValueString())] = member.Email.ValueString()
+	}
+
+	members := []ProjectMembersModel{}
+	for _, membership := range memberships {
+		email := membership.User.Email
+		if spelling, ok := configured[strings.ToLower(email)]; ok {
+			email = spelling
+		}
+		members = append(members, ProjectMembersModel{
+			Email: types.StringValue(email),
+			Role:  types.StringValue(membership.Role),
+		})
+	}
+
+	state.ID = state.ProjectId
+	state.Members = members
+	return nil
+}
+
+// Create reconciles the project members with the configuration.
+func (r *ProjectMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, plan.ProjectId.ValueString(), plan.Members); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Infisical Project Members",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.ProjectId
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProjectMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.refresh(ctx, &state); err != nil {
+		if ic.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Infisical Project Memberships",
+			err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update reconciles the project members with the configuration.
+func (r *ProjectMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, plan.ProjectId.ValueString(), plan.Members); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Infisical Project Members",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.ProjectId
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete stops managing the member list. Memberships are left in place, as
+// removing every member would also lock the provider out of the project.
+func (r *ProjectMembersResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports the member list of a project by project id.
+func (r *ProjectMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
+}
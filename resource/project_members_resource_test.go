@@ -0,0 +1,82 @@
+package resource_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var projectMembersConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+resource "infisical_project_members" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+
+    members = [
+        {
+            email = "owner@example.com"
+            role  = "admin"
+        },
+        {
+            email = "member@example.com"
+            role  = "member"
+        },
+    ]
+}
+`
+
+var projectMembersWithoutSelfConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+resource "infisical_project_members" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+
+    members = [
+        {
+            email = "member@example.com"
+            role  = "member"
+        },
+    ]
+}
+`
+
+func TestAccProjectMembersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Removing the authenticated user is rejected at plan time
+			{
+				Config:      tu.ProviderConfig + projectMembersWithoutSelfConfig,
+				ExpectError: regexp.MustCompile("Authenticated User Not Listed"),
+			},
+			// Create and Read testing
+			{
+				Config: tu.ProviderConfig + projectMembersConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("infisical_project_members.test", "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("infisical_project_members.test", "members.*", map[string]string{
+						"email": "member@example.com",
+						"role":  "member",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "infisical_project_members.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package resource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRemovedMembers(t *testing.T) {
+	desired := []ProjectMembersModel{
+		{Email: types.StringValue("Owner@example.com"), Role: types.StringValue("admin")},
+		{Email: types.StringValue("member@example.com"), Role: types.StringValue("member")},
+	}
+
+	// On creation current holds every member of the project, including
+	// members that were added outside of Terraform.
+	current := []string{"owner@example.com", "member@example.com", "zed@example.com", "contractor@example.com"}
+
+	removed := removedMembers(current, desired)
+	expected := []string{"contractor@example.com", "zed@example.com"}
+	if !reflect.DeepEqual(removed, expected) {
+		t.Fatalf("expected %v, got %v", expected, removed)
+	}
+
+	if removed := removedMembers([]string{"owner@example.com"}, desired); len(removed) != 0 {
+		t.Fatalf("expected no removals, got %v", removed)
+	}
+}
+
+func TestListsMember(t *testing.T) {
+	members := []ProjectMembersModel{
+		{Email: types.StringValue("Owner@example.com"), Role: types.StringValue("admin")},
+	}
+
+	if !listsMember(members, "owner@example.com") {
+		t.Fatal("expected the authenticated user to be found regardless of case")
+	}
+	if listsMember(members, "member@example.com") {
+		t.Fatal("expected a user missing from the configuration not to be found")
+	}
+}
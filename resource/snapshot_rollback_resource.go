@@ -0,0 +1,350 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	ic "github.com/asheliahut/terraform-provider-infisical/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &SnapshotRollbackResource{}
+	_ resource.ResourceWithConfigure  = &SnapshotRollbackResource{}
+	_ resource.ResourceWithModifyPlan = &SnapshotRollbackResource{}
+)
+
+// rollbackChangeType is the object type of the elements of changes.
+var rollbackChangeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"environment": types.StringType,
+		"key":         types.StringType,
+		"action":      types.StringType,
+	},
+}
+
+// NewSnapshotRollbackResource is a helper function to simplify the provider implementation.
+func NewSnapshotRollbackResource() resource.Resource {
+	return &SnapshotRollbackResource{}
+}
+
+// SnapshotRollbackResource is the resource implementation.
+type SnapshotRollbackResource struct {
+	client *ic.Session
+}
+
+// SnapshotRollbackResourceModel maps the resource schema data.
+type SnapshotRollbackResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ProjectId       types.String `tfsdk:"project_id"`
+	Version         types.Int64  `tfsdk:"version"`
+	SnapshotId      types.String `tfsdk:"snapshot_id"`
+	SnapshotVersion types.Int64  `tfsdk:"snapshot_version"`
+	Changes         types.List   `tfsdk:"changes"`
+}
+
+// RollbackChangeModel maps changes schema data.
+type RollbackChangeModel struct {
+	Environment types.String `tfsdk:"environment"`
+	Key         types.String `tfsdk:"key"`
+	Action      types.String `tfsdk:"action"`
+}
+
+// Metadata returns the resource type name.
+func (r *SnapshotRollbackResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_rollback"
+}
+
+// Schema defines the schema for the resource.
+func (r *SnapshotRollbackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rolls the secrets of a project back to a snapshot version whenever version changes, including on creation. " +
+			"Infisical rolls back all environments of the project at once. The plan lists the secret keys the rollback changes, " +
+			"which requires the private_key provider attribute. Destroying the resource does not undo the rollback.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Identifier of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Description: "Version of the snapshot to roll back to.",
+				Required:    true,
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "Identifier of the snapshot Infisical took after the rollback.",
+				Computed:    true,
+			},
+			"snapshot_version": schema.Int64Attribute{
+				Description: "Version of the snapshot Infisical took after the rollback.",
+				Computed:    true,
+			},
+			"changes": schema.ListNestedAttribute{
+				Description: "Secrets the rollback changes, computed while planning.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"environment": schema.StringAttribute{
+							Description: "Slug of the environment of the secret.",
+							Computed:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "Key of the secret.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "What the rollback does to the secret: create, update or delete.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SnapshotRollbackResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ic.Session)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Session, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan computes the changes of a rollback by comparing the decrypted
+// secrets of the snapshot with the current ones.
+func (r *SnapshotRollbackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SnapshotRollbackResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ProjectId.IsUnknown() || plan.Version.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state SnapshotRollbackResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Nothing is rolled back unless the version changes.
+		if state.Version.Equal(plan.Version) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("snapshot_id"), state.SnapshotId)...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("snapshot_version"), state.SnapshotVersion)...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("changes"), state.Changes)...)
+			return
+		}
+	}
+
+	changes, err := r.changes(ctx, plan.ProjectId.ValueString(), int(plan.Version.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Compute Infisical Rollback Changes",
+			fmt.Sprintf("The rollback will still be applied, but its changes cannot be shown: %s", err),
+		)
+		return
+	}
+
+	changesValue, diags := types.ListValueFrom(ctx, rollbackChangeType, changes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("changes"), changesValue)...)
+}
+
+// changes compares the shared secrets of a snapshot with the current ones
+// of every environment and returns what a rollback changes, sorted by
+// environment and key.
+func (r *SnapshotRollbackResource) changes(ctx context.Context, workspaceId string, version int) ([]RollbackChangeModel, error) {
+	snapshot, err := r.client.FindSnapshot(ctx, workspaceId, version)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("project %s has no snapshot with version %d", workspaceId, version)
+	}
+
+	target, err := r.client.SnapshotSecrets(ctx, workspaceId, snapshot.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := r.client.GetWorkspace(ctx, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	type secretKey struct{ environment, key string }
+	wanted := map[secretKey]string{}
+	for _, secret := range target {
+		if secret.Type == "shared" {
+			wanted[secretKey{secret.Environment, secret.Key}] = secret.Value
+		}
+	}
+	current := map[secretKey]string{}
+	for _, environment := range project.Environments {
+		secrets, err := r.client.ListSecrets(ctx, workspaceId, environment.Slug)
+		if err != nil {
+			return nil, err
+		}
+		for _, secret := range secrets {
+			if secret.Type == "shared" {
+				current[secretKey{secret.Environment, secret.Key}] = secret.Value
+			}
+		}
+	}
+
+	changes := []RollbackChangeModel{}
+	add := func(key secretKey, action string) {
+		changes = append(changes, RollbackChangeModel{
+			Environment: types.StringValue(key.environment),
+			Key:         types.StringValue(key.key),
+			Action:      types.StringValue(action),
+		})
+	}
+	for key, value := range wanted {
+		currentValue, ok := current[key]
+		if !ok {
+			add(key, "create")
+		} else if currentValue != value {
+			add(key, "update")
+		}
+	}
+	for key := range current {
+		if _, ok := wanted[key]; !ok {
+			add(key, "delete")
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Environment.ValueString() != changes[j].Environment.ValueString() {
+			return changes[i].Environment.ValueString() < changes[j].Environment.ValueString()
+		}
+		return changes[i].Key.ValueString() < changes[j].Key.ValueString()
+	})
+
+	return changes, nil
+}
+
+// rollback rolls the project back and records the snapshot taken afterwards.
+func (r *SnapshotRollbackResource) rollback(ctx context.Context, plan *SnapshotRollbackResourceModel) error {
+	version := int(plan.Version.ValueInt64())
+	res, err := r.client.PostApiV1WorkspaceWorkspaceIdSecretSnapshotsRollback(ctx, plan.ProjectId.ValueString(), ic.PostApiV1WorkspaceWorkspaceIdSecretSnapshotsRollbackJSONRequestBody{
+		Version: &version,
+	})
+	if err != nil {
+		return err
+	}
+	if err := ic.DecodeResponse(res, nil); err != nil {
+		return err
+	}
+
+	snapshots, err := r.client.ListSnapshots(ctx, plan.ProjectId.ValueString(), 0, 1)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 {
+		return fmt.Errorf("project %s has no snapshots after the rollback", plan.ProjectId.ValueString())
+	}
+
+	plan.SnapshotId = types.StringValue(snapshots[0].ID)
+	plan.SnapshotVersion = types.Int64Value(int64(snapshots[0].Version))
+	if plan.Changes.IsUnknown() {
+		plan.Changes = types.ListNull(rollbackChangeType)
+	}
+
+	return nil
+}
+
+// Create rolls the project back to the configured version.
+func (r *SnapshotRollbackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SnapshotRollbackResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.rollback(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Roll Back Infisical Project",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.ProjectId
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the recorded rollback, as there is nothing to refresh.
+func (r *SnapshotRollbackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SnapshotRollbackResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update rolls the project back to the new version.
+func (r *SnapshotRollbackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SnapshotRollbackResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.rollback(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Roll Back Infisical Project",
+			err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the resource from state, a rollback cannot be undone.
+func (r *SnapshotRollbackResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
@@ -0,0 +1,43 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tu "github.com/asheliahut/terraform-provider-infisical/testingutils"
+)
+
+var snapshotRollbackConfig = `
+data "infisical_organizations" "test" {}
+
+data "infisical_projects" "test" {
+    organization_id = data.infisical_organizations.test.organizations.0.id
+}
+
+data "infisical_secret_snapshots" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+    limit      = 1
+}
+
+resource "infisical_snapshot_rollback" "test" {
+    project_id = data.infisical_projects.test.projects.0.id
+    version    = data.infisical_secret_snapshots.test.snapshots.0.version
+}
+`
+
+func TestAccSnapshotRollbackResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: tu.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: tu.ProviderConfig + snapshotRollbackConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("infisical_snapshot_rollback.test", "snapshot_id"),
+					resource.TestCheckResourceAttrSet("infisical_snapshot_rollback.test", "snapshot_version"),
+				),
+			},
+		},
+	})
+}
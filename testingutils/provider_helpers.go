@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/asheliahut/terraform-provider-infisical/provider"
@@ -41,3 +42,16 @@ func PrintOutput(s *terraform.State) error {
 	}
 	return nil
 }
+
+// CaptureResourceAttr stores the value of an attribute in value, so that a
+// later step can compare against it with resource.TestCheckResourceAttrPtr.
+func CaptureResourceAttr(name string, key string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+		*value = rs.Primary.Attributes[key]
+		return nil
+	}
+}